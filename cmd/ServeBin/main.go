@@ -6,16 +6,30 @@
 package main
 
 import (
+	"ServeBin/config"
 	"ServeBin/controller"
 	_ "ServeBin/docs"
 	"ServeBin/helper"
 	"ServeBin/router"
 	"ServeBin/service"
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/joho/godotenv"
+	"github.com/quic-go/quic-go/http3"
+	"io"
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 )
 
 // @title           	ServeBin
@@ -23,6 +37,8 @@ import (
 // @description			Welcome to ServeBin documentation! ServeBin is a cutting-edge HTTP testing and debugging tool, built with the latest technologies in Go. This documentation provides comprehensive details about the endpoints, parameters, and responses offered by ServeBin, empowering developers to streamline their testing workflows and ensure the reliability of their applications. Explore the various features and capabilities of ServeBin to optimize your development process and elevate your HTTP testing experience.
 // @termsOfService  	https://servebin.dev/
 
+// @contact.email		contact@mrayush.me
+
 // @license.name  		BSD 3-Clause
 // @license.url  		https://github.com/AyushAgnihotri2025/ServeBin/blob/master/LICENSE
 
@@ -46,11 +62,40 @@ import (
 
 // @tag.name			HTTP Methods
 // @tag.description 	Testing different HTTP verbs
+
+// @tag.name			Response inspection
+// @tag.description 	Inspect the response data like caching and headers
+
+// @tag.name			Redirects
+// @tag.description 	Returns different redirect responses
+
+// @tag.name			Auth
+// @tag.description 	Auth methods
+
+// @tag.name			Cookies
+// @tag.description 	Creates, reads and deletes cookies
+
+// @tag.name			Dynamic data
+// @tag.description 	Generates random and dynamic data
+
+// @tag.name			Request bins
+// @tag.description 	Capture and replay inbound requests
+
+// @tag.name			WebSocket
+// @tag.description 	Tests WebSocket clients
 func main() {
+	if err := run(); err != nil {
+		log.Printf("ServeBin stopped: %v", err)
+		os.Exit(1)
+	}
+}
+
+// Serves until a signal asks to stop or a listener fails
+func run() error {
 	if _, err := os.Stat(".env"); err == nil {
 		err := godotenv.Load()
 		if err != nil {
-			log.Fatal("Error loading .env file")
+			return fmt.Errorf("error loading .env file: %w", err)
 		}
 	} else {
 		log.Printf(".env file doesn't exist, assuming you have already set all env vars")
@@ -58,21 +103,260 @@ func main() {
 
 	// Validator
 	validate := validator.New()
+	config.RegisterValidations(validate)
+
+	// Config
+	flags, err := config.ParseFlags(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.Load(flags, validate)
+	if err != nil {
+		return err
+	}
+
+	if flags.PrintConfig {
+		return cfg.Print(os.Stdout)
+	}
+
+	gin.SetMode(cfg.Server.GinMode)
+
+	// Headless mode keeps stdout for the addresses of the listeners
+	if cfg.Server.Headless {
+		gin.DefaultWriter = os.Stderr
+	}
+
+	// Storage
+	storage, err := service.NewStorage(cfg.Storage.Backend, service.StorageOptions{
+		Path:        cfg.Storage.Path,
+		TTL:         cfg.Storage.TTL.Duration,
+		MaxRequests: cfg.Storage.MaxRequests,
+		MaxBins:     cfg.Storage.MaxBins,
+
+		MaxRequestBytes: cfg.Storage.MaxRequestBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("error opening the %s storage: %w", cfg.Storage.Backend, err)
+	}
+	defer storage.Close()
 
 	// Service
-	tagsService := service.NewAPIServiceImpl(validate)
+	tagsService := service.NewAPIServiceImpl(validate, cfg, storage)
 
 	// Controller
-	tagsController := controller.NewAPIController(tagsService)
+	tagsController := controller.NewAPIController(tagsService, cfg)
 
 	// Router
-	routes := router.NewRouter(tagsController)
+	routes := router.NewRouter(tagsController, cfg)
+
+	// gRPC
+	grpcServer := router.NewGRPCServer(controller.NewGRPCController(tagsService, cfg))
+
+	httpsAddr := cfg.Server.HTTPSAddr()
+	http3Addr := cfg.Server.HTTP3Addr()
+
+	var tlsConfig *tls.Config
+	if httpsAddr != "" || http3Addr != "" || cfg.TLS.Enabled {
+		tlsConfig, err = helper.NewTLSConfig(cfg.TLS, cfg.Server.Host)
+		if err != nil {
+			return fmt.Errorf("error configuring TLS: %w", err)
+		}
+	}
+
+	// Every listener is bound before any of them serves, so that a failure
+	// leaves nothing running and the ports picked by the system for port 0
+	// can be reported
+	var addresses helper.ServerAddresses
+	var bound []io.Closer
+	serving := false
+	defer func() {
+		if !serving {
+			for _, closer := range bound {
+				closer.Close()
+			}
+		}
+	}()
+
+	var grpcListener net.Listener
+	if grpcAddr := cfg.Server.GRPCAddr(); grpcAddr != "" {
+		grpcListener, err = net.Listen("tcp", grpcAddr)
+		if err != nil {
+			return err
+		}
+		bound = append(bound, grpcListener)
+		addresses.GRPCAddr = grpcListener.Addr().String()
+	}
+
+	var http3Conn net.PacketConn
+	if http3Addr != "" {
+		http3Conn, err = net.ListenPacket("udp", http3Addr)
+		if err != nil {
+			return err
+		}
+		bound = append(bound, http3Conn)
+		addresses.HTTP3Addr = http3Conn.LocalAddr().String()
+	}
+
+	var httpsListener net.Listener
+	if httpsAddr != "" {
+		httpsListener, err = net.Listen("tcp", httpsAddr)
+		if err != nil {
+			return err
+		}
+		bound = append(bound, httpsListener)
+		addresses.HTTPSAddr = httpsListener.Addr().String()
+	}
+
+	listener, err := net.Listen("tcp", cfg.Server.Addr())
+	if err != nil {
+		return err
+	}
+	bound = append(bound, listener)
+	addresses.Addr = listener.Addr().String()
+
+	// From now on the servers own the listeners
+	serving = true
+
+	// Every listener reports here why it stopped serving, and registers how
+	// to shut it down
+	serveErrors := make(chan error, 4)
+	var shutdowns []func(ctx context.Context) error
+
+	// gRPC shares the HTTP listener unless it has a port of its own
+	sharedGRPCServer := grpcServer
+	if grpcListener != nil {
+		log.Printf("gRPC server listening on %s", addresses.GRPCAddr)
+		go func() {
+			serveErrors <- grpcServer.Serve(grpcListener)
+		}()
+		shutdowns = append(shutdowns, func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
 
-	server := &http.Server{
-		Addr:    helper.GetHost(),
-		Handler: routes,
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				grpcServer.Stop()
+				return ctx.Err()
+			}
+		})
+		sharedGRPCServer = nil
 	}
 
-	err := server.ListenAndServe()
-	helper.ErrorPanic(err)
+	requests := &router.RequestTracker{}
+	handler := router.NewServerHandler(routes, sharedGRPCServer, requests)
+
+	// HTTP/3 listens on UDP and is advertised to the TCP clients with Alt-Svc
+	if http3Conn != nil {
+		http3Server := &http3.Server{
+			Handler:        handler,
+			TLSConfig:      tlsConfig,
+			IdleTimeout:    cfg.Server.IdleTimeout.Duration,
+			MaxHeaderBytes: cfg.Server.MaxHeaderBytes,
+		}
+
+		log.Printf("HTTP/3 server listening on %s (udp)", addresses.HTTP3Addr)
+		go func() {
+			serveErrors <- http3Server.Serve(http3Conn)
+		}()
+		shutdowns = append(shutdowns, gracefully(http3Server.Shutdown, http3Server.Close))
+		handler = router.NewAltSvcHandler(handler, http3Server)
+	}
+
+	newServer := func() *http.Server {
+		return &http.Server{
+			Handler:           handler,
+			TLSConfig:         tlsConfig,
+			ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout.Duration,
+			IdleTimeout:       cfg.Server.IdleTimeout.Duration,
+			MaxHeaderBytes:    cfg.Server.MaxHeaderBytes,
+		}
+	}
+
+	// HTTPS listens next to HTTP when it has a port of its own
+	if httpsListener != nil {
+		httpsServer := newServer()
+
+		log.Printf("HTTPS server listening on %s", addresses.HTTPSAddr)
+		go func() {
+			serveErrors <- httpsServer.ServeTLS(httpsListener, "", "")
+		}()
+		shutdowns = append(shutdowns, gracefully(httpsServer.Shutdown, httpsServer.Close))
+	}
+
+	server := newServer()
+	go func() {
+		if cfg.TLS.Enabled {
+			serveErrors <- server.ServeTLS(listener, "", "")
+		} else {
+			serveErrors <- server.Serve(listener)
+		}
+	}()
+	shutdowns = append(shutdowns, gracefully(server.Shutdown, server.Close))
+
+	// Kubernetes stops the pods with SIGTERM
+	signals, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// A server that can't tell where it listens stops like a failed listener
+	err = helper.AnnounceServer(cfg, addresses)
+	if err == nil {
+		select {
+		case err = <-serveErrors:
+		case <-signals.Done():
+		}
+	}
+	// A second signal kills the server without waiting for the drain
+	stop()
+
+	tagsService.StartDraining()
+	log.Printf("Shutting down, draining the connections for up to %s", cfg.Server.ShutdownDelay.Duration+cfg.Server.ShutdownTimeout.Duration)
+
+	// Fail the readiness probe first, so that the load balancers stop sending
+	// new requests before the listeners are closed. A failed server doesn't
+	// get to serve them anyway.
+	if err == nil {
+		time.Sleep(cfg.Server.ShutdownDelay.Duration)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout.Duration)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, shutdown := range shutdowns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := shutdown(ctx); err != nil {
+				log.Printf("Server didn't shut down gracefully: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The h2c and WebSocket connections aren't tracked by the HTTP servers
+	if err := requests.Wait(ctx); err != nil {
+		log.Printf("Requests still in flight were cut off: %v", err)
+	}
+
+	return err
+}
+
+// Shuts a server down gracefully, and closes it if ctx is done first
+func gracefully(shutdown func(ctx context.Context) error, close func() error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if err := shutdown(ctx); err != nil {
+			close()
+			return err
+		}
+		return nil
+	}
 }
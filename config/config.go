@@ -0,0 +1,162 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package config loads the settings of ServeBin from a YAML or TOML file, the
+// environment and the command line, in increasing order of precedence.
+package config
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	Server   ServerConfig   `yaml:"server" toml:"server"`
+	TLS      TLSConfig      `yaml:"tls" toml:"tls"`
+	Storage  StorageConfig  `yaml:"storage" toml:"storage"`
+	Limits   LimitsConfig   `yaml:"limits" toml:"limits"`
+	Redirect RedirectConfig `yaml:"redirect" toml:"redirect"`
+}
+
+type ServerConfig struct {
+	Host           string `yaml:"host" toml:"host" env:"HOST" flag:"host" usage:"host where to listen to the requests, 0.0.0.0 listens on every interface" validate:"required"`
+	Port           int    `yaml:"port" toml:"port" env:"PORT" flag:"port" usage:"port of the HTTP listener" validate:"min=0,max=65535"`
+	Env            string `yaml:"env" toml:"env" env:"ENV" flag:"env" usage:"development or production" validate:"oneof=development production"`
+	GinMode        string `yaml:"gin_mode" toml:"gin_mode" env:"GIN_MODE" flag:"gin-mode" usage:"debug, release or test" validate:"oneof=debug release test"`
+	Headless       bool   `yaml:"headless" toml:"headless" env:"HEADLESS" flag:"headless" usage:"never open the browser, print the bound addresses as JSON on stdout and keep the logs on stderr"`
+	IsBackupServer bool   `yaml:"is_backup_server" toml:"is_backup_server" env:"IS_BACKUP_SERVER" flag:"backup-server" usage:"redirect the home page, docs and sitemap to the main server"`
+	MainServer     string `yaml:"main_server" toml:"main_server" env:"MAIN_SERVER" flag:"main-server" usage:"URL of the main server the backup server redirects to" validate:"required_if=IsBackupServer true,omitempty,url"`
+	GRPCPort       int    `yaml:"grpc_port" toml:"grpc_port" env:"GRPC_PORT" flag:"grpc-port" usage:"port of a dedicated gRPC listener, 0 serves gRPC on the HTTP port (h2c)" validate:"min=0,max=65535"`
+	HTTPSPort      int    `yaml:"https_port" toml:"https_port" env:"HTTPS_PORT" flag:"https-port" usage:"port of an HTTPS listener running next to the HTTP one, 0 disables it" validate:"min=0,max=65535"`
+	HTTP3Port      int    `yaml:"http3_port" toml:"http3_port" env:"HTTP3_PORT" flag:"http3-port" usage:"UDP port of the HTTP/3 listener, 0 disables it" validate:"min=0,max=65535"`
+
+	ReadHeaderTimeout Duration `yaml:"read_header_timeout" toml:"read_header_timeout" env:"READ_HEADER_TIMEOUT" flag:"read-header-timeout" usage:"time allowed to read the request headers, 0 means no timeout" validate:"min=0"`
+	IdleTimeout       Duration `yaml:"idle_timeout" toml:"idle_timeout" env:"IDLE_TIMEOUT" flag:"idle-timeout" usage:"keep-alive connections idle for longer than this are closed, 0 means no timeout" validate:"min=0"`
+	MaxHeaderBytes    int      `yaml:"max_header_bytes" toml:"max_header_bytes" env:"MAX_HEADER_BYTES" flag:"max-header-bytes" usage:"largest size in bytes of the request headers" validate:"min=1"`
+	ShutdownDelay     Duration `yaml:"shutdown_delay" toml:"shutdown_delay" env:"SHUTDOWN_DELAY" flag:"shutdown-delay" usage:"time /ready reports draining before the listeners are closed on SIGTERM" validate:"min=0"`
+	ShutdownTimeout   Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" flag:"shutdown-timeout" usage:"time the in-flight requests get to finish on SIGTERM before they are cut off" validate:"min=0"`
+}
+
+type TLSConfig struct {
+	Enabled      bool   `yaml:"enabled" toml:"enabled" env:"IS_SSL" flag:"ssl" usage:"serve HTTPS on the HTTP port"`
+	CertFile     string `yaml:"cert_file" toml:"cert_file" env:"TLS_CERT_FILE" flag:"tls-cert-file" usage:"PEM encoded certificate, a self-signed one is generated when empty" validate:"required_with=KeyFile"`
+	KeyFile      string `yaml:"key_file" toml:"key_file" env:"TLS_KEY_FILE" flag:"tls-key-file" usage:"PEM encoded private key of the certificate" validate:"required_with=CertFile"`
+	ClientAuth   string `yaml:"client_auth" toml:"client_auth" env:"TLS_CLIENT_AUTH" flag:"tls-client-auth" usage:"none, request, require, verify_if_given or require_and_verify" validate:"oneof=none request require verify_if_given require_and_verify"`
+	ClientCAFile string `yaml:"client_ca_file" toml:"client_ca_file" env:"TLS_CLIENT_CA_FILE" flag:"tls-client-ca-file" usage:"PEM encoded CA bundle the client certificates are verified against" validate:"required_if=ClientAuth verify_if_given,required_if=ClientAuth require_and_verify"`
+}
+
+type StorageConfig struct {
+	Backend     string   `yaml:"backend" toml:"backend" env:"STORAGE" flag:"storage" usage:"storage of the request bins, memory or bolt" validate:"oneof=memory bolt"`
+	Path        string   `yaml:"path" toml:"path" env:"STORAGE_PATH" flag:"storage-path" usage:"database file used by the bolt storage" validate:"required_if=Backend bolt"`
+	TTL         Duration `yaml:"ttl" toml:"ttl" env:"STORAGE_TTL" flag:"storage-ttl" usage:"bins idle for longer than this are evicted, 0 disables the eviction" validate:"min=0"`
+	MaxRequests int      `yaml:"max_requests" toml:"max_requests" env:"STORAGE_MAX_REQUESTS" flag:"storage-max-requests" usage:"maximum number of requests kept per bin" validate:"min=1"`
+	MaxBins     int      `yaml:"max_bins" toml:"max_bins" env:"STORAGE_MAX_BINS" flag:"storage-max-bins" usage:"maximum number of bins, 0 means unlimited" validate:"min=0"`
+
+	MaxRequestBytes int64 `yaml:"max_request_bytes" toml:"max_request_bytes" env:"STORAGE_MAX_REQUEST_BYTES" flag:"storage-max-request-bytes" usage:"largest body in bytes a bin records, bigger requests are refused with 413" validate:"min=1"`
+}
+
+type LimitsConfig struct {
+	MaxDelay            Duration `yaml:"max_delay" toml:"max_delay" env:"MAX_DELAY" flag:"max-delay" usage:"longest delay the server waits before replying, plain numbers are seconds" validate:"min=0"`
+	MaxBytes            int      `yaml:"max_bytes" toml:"max_bytes" env:"MAX_BYTES" flag:"max-bytes" usage:"largest payload in bytes /bytes, /stream-bytes and /range generate" validate:"min=1"`
+	MaxDecompressedBody int64    `yaml:"max_decompressed_body" toml:"max_decompressed_body" env:"MAX_DECOMPRESSED_BODY" flag:"max-decompressed-body" usage:"largest size in bytes of a compressed request body, before and after decoding" validate:"min=1"`
+}
+
+type RedirectConfig struct {
+	AllowedHosts []string `yaml:"allowed_hosts" toml:"allowed_hosts" env:"REDIRECT_ALLOWED_HOSTS" flag:"redirect-allowed-hosts" usage:"comma separated hosts /redirect-to may redirect to, empty allows every host"`
+}
+
+// Default returns the settings used when nothing else is configured
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Host:    "127.0.0.1",
+			Port:    8888,
+			Env:     "development",
+			GinMode: "debug",
+
+			ReadHeaderTimeout: Duration{10 * time.Second},
+			IdleTimeout:       Duration{120 * time.Second},
+			MaxHeaderBytes:    1 << 20,
+			ShutdownTimeout:   Duration{30 * time.Second},
+		},
+		TLS: TLSConfig{
+			ClientAuth: "none",
+		},
+		Storage: StorageConfig{
+			Backend:     "memory",
+			Path:        "servebin.db",
+			TTL:         Duration{24 * time.Hour},
+			MaxRequests: 100,
+			MaxBins:     1000,
+
+			MaxRequestBytes: 1 << 20,
+		},
+		Limits: LimitsConfig{
+			MaxDelay:            Duration{10 * time.Second},
+			MaxBytes:            100 * 1024,
+			MaxDecompressedBody: 10 << 20,
+		},
+	}
+}
+
+// Addr is the address of the HTTP listener
+func (c ServerConfig) Addr() string {
+	return c.listenAddr(c.Port)
+}
+
+// GRPCAddr is the address of the gRPC listener, empty when gRPC shares the HTTP listener
+func (c ServerConfig) GRPCAddr() string {
+	if c.GRPCPort == 0 {
+		return ""
+	}
+	return c.listenAddr(c.GRPCPort)
+}
+
+// HTTPSAddr is the address of the HTTPS listener running next to the HTTP one, empty when disabled
+func (c ServerConfig) HTTPSAddr() string {
+	if c.HTTPSPort == 0 {
+		return ""
+	}
+	return c.listenAddr(c.HTTPSPort)
+}
+
+// HTTP3Addr is the UDP address of the HTTP/3 listener, empty when disabled
+func (c ServerConfig) HTTP3Addr() string {
+	if c.HTTP3Port == 0 {
+		return ""
+	}
+	return c.listenAddr(c.HTTP3Port)
+}
+
+func (c ServerConfig) listenAddr(port int) string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(port))
+}
+
+// Duration reads "90s" or "24h" like time.ParseDuration, plain numbers are seconds
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	value := strings.TrimSpace(string(text))
+
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		d.Duration = time.Duration(seconds * float64(time.Second))
+		return nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	d.Duration = duration
+	return nil
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.Duration.String()), nil
+}
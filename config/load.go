@@ -0,0 +1,239 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package config
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Flags is the parsed command line of the server
+type Flags struct {
+	// ConfigFile is the YAML or TOML file to load, CONFIG_FILE when empty
+	ConfigFile string
+	// PrintConfig asks to print the resolved settings and exit
+	PrintConfig bool
+
+	overrides []override
+}
+
+type override struct {
+	flag  string
+	value string
+}
+
+// flagValue records the settings given on the command line, they are applied
+// by Load once the file and the environment have been read
+type flagValue struct {
+	flags  *Flags
+	name   string
+	isBool bool
+}
+
+func (v *flagValue) String() string { return "" }
+
+func (v *flagValue) Set(value string) error {
+	v.flags.overrides = append(v.flags.overrides, override{flag: v.name, value: value})
+	return nil
+}
+
+func (v *flagValue) IsBoolFlag() bool { return v.isBool }
+
+// ParseFlags parses the command line arguments, without the program name
+func ParseFlags(args []string) (*Flags, error) {
+	flags := &Flags{}
+
+	flagSet := flag.NewFlagSet("ServeBin", flag.ContinueOnError)
+	flagSet.StringVar(&flags.ConfigFile, "config", "", "YAML or TOML configuration file (env CONFIG_FILE)")
+	flagSet.BoolVar(&flags.PrintConfig, "print-config", false, "print the resolved configuration as YAML and exit")
+
+	walk(reflect.ValueOf(Default()).Elem(), func(field reflect.StructField, _ reflect.Value) {
+		name := field.Tag.Get("flag")
+		usage := fmt.Sprintf("%s (env %s)", field.Tag.Get("usage"), field.Tag.Get("env"))
+		flagSet.Var(&flagValue{flags: flags, name: name, isBool: field.Type.Kind() == reflect.Bool}, name, usage)
+	})
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, err
+	}
+	if flagSet.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %q", flagSet.Arg(0))
+	}
+
+	return flags, nil
+}
+
+// RegisterValidations teaches validate to check the settings, call it once
+// where the validator is built
+func RegisterValidations(validate *validator.Validate) {
+	// Durations are validated as a number of nanoseconds
+	validate.RegisterCustomTypeFunc(func(value reflect.Value) interface{} {
+		return int64(value.Interface().(Duration).Duration)
+	}, Duration{})
+}
+
+// Load resolves the settings, each source overriding the previous one:
+// the defaults, the configuration file, the environment and the flags
+func Load(flags *Flags, validate *validator.Validate) (*Config, error) {
+	cfg := Default()
+
+	configFile := flags.ConfigFile
+	if configFile == "" {
+		configFile = os.Getenv("CONFIG_FILE")
+	}
+	if configFile != "" {
+		if err := cfg.loadFile(configFile); err != nil {
+			return nil, fmt.Errorf("config file %s: %w", configFile, err)
+		}
+	}
+
+	var err error
+	walk(reflect.ValueOf(cfg).Elem(), func(field reflect.StructField, value reflect.Value) {
+		// A variable set to an empty string clears the setting of the file
+		key := field.Tag.Get("env")
+		if env, ok := os.LookupEnv(key); ok && err == nil {
+			if setErr := setValue(value, env); setErr != nil {
+				err = fmt.Errorf("invalid value %q for %s: %w", env, key, setErr)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, override := range flags.overrides {
+		walk(reflect.ValueOf(cfg).Elem(), func(field reflect.StructField, value reflect.Value) {
+			if field.Tag.Get("flag") == override.flag && err == nil {
+				if setErr := setValue(value, override.value); setErr != nil {
+					err = fmt.Errorf("invalid value %q for --%s: %w", override.value, override.flag, setErr)
+				}
+			}
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.normalize()
+
+	if err := validate.Struct(cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// Print writes the settings as YAML, in the format of the configuration file
+func (c *Config) Print(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// Reads a YAML or TOML file, picked by its extension. Unknown keys are
+// rejected so that typos don't go unnoticed.
+func (c *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(file)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(c); err != nil && err != io.EOF {
+			return err
+		}
+	case ".toml":
+		decoder := toml.NewDecoder(file)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(c); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format %q, use .yaml, .yml or .toml", filepath.Ext(path))
+	}
+
+	return nil
+}
+
+// Puts the values which are compared case insensitively in lower case
+func (c *Config) normalize() {
+	c.TLS.ClientAuth = strings.ToLower(c.TLS.ClientAuth)
+
+	var hosts []string
+	for _, host := range c.Redirect.AllowedHosts {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	c.Redirect.AllowedHosts = hosts
+}
+
+// Calls visit with every setting of a section, recursing into the sub-sections
+func walk(section reflect.Value, visit func(field reflect.StructField, value reflect.Value)) {
+	for i := 0; i < section.NumField(); i++ {
+		field := section.Type().Field(i)
+		value := section.Field(i)
+
+		if field.Tag.Get("env") == "" {
+			walk(value, visit)
+			continue
+		}
+		visit(field, value)
+	}
+}
+
+// Parses a setting given as text in the environment or on the command line,
+// an empty text clears it
+func setValue(value reflect.Value, text string) error {
+	if text == "" {
+		value.Set(reflect.Zero(value.Type()))
+		return nil
+	}
+
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(parsed)
+	case reflect.Slice:
+		value.Set(reflect.ValueOf(strings.Split(text, ",")))
+	default:
+		return fmt.Errorf("unsupported setting type %s", value.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/data/response"
+	"ServeBin/helper"
+	"ServeBin/service"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"net/http"
+)
+
+// BasicAuth	 	ServeBin
+// @Tags			Auth
+// @Summary			Prompts the user for authorization using HTTP Basic Auth.
+// @Description		Succeeds when the Basic credentials match the user and password in the path.
+// @Param        	user  		path  	string  true  	"User"
+// @Param        	passwd  	path  	string  true  	"Password"
+// @Success			200			{object}	response.AuthResponse
+// @Failure      	401  		{object}  	response.HTTPError
+// @Router			/basic-auth/{user}/{passwd} 	[get]
+func (controller *APIController) BasicAuth(ctx *gin.Context) {
+	user := ctx.Param("user")
+
+	if err := controller.apiService.ValidateBasicAuth(ctx, user, ctx.Param("passwd")); err != nil {
+		ctx.Header("WWW-Authenticate", `Basic realm="ServeBin"`)
+		helper.NewError(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.AuthResponse{Authenticated: true, User: user})
+}
+
+// HiddenBasicAuth	ServeBin
+// @Tags			Auth
+// @Summary			Prompts the user for authorization using HTTP Basic Auth.
+// @Description		Same as /basic-auth but answers 404 instead of challenging the client.
+// @Param        	user  		path  	string  true  	"User"
+// @Param        	passwd  	path  	string  true  	"Password"
+// @Success			200			{object}	response.AuthResponse
+// @Failure      	404  		{object}  	response.HTTPError
+// @Router			/hidden-basic-auth/{user}/{passwd} 	[get]
+func (controller *APIController) HiddenBasicAuth(ctx *gin.Context) {
+	user := ctx.Param("user")
+
+	if err := controller.apiService.ValidateBasicAuth(ctx, user, ctx.Param("passwd")); err != nil {
+		helper.NewError(ctx, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.AuthResponse{Authenticated: true, User: user})
+}
+
+// BearerAuth	 	ServeBin
+// @Tags			Auth
+// @Summary			Prompts the user for authorization using bearer authentication.
+// @Description		Succeeds when any bearer token is sent in the Authorization header.
+// @Param        	Authorization  	header  	string  true  	"Bearer token"
+// @Success			200			{object}	response.BearerResponse
+// @Failure      	401  		{object}  	response.HTTPError
+// @Router			/bearer 	[get]
+func (controller *APIController) BearerAuth(ctx *gin.Context) {
+	token, err := controller.apiService.ValidateBearerToken(ctx)
+	if err != nil {
+		ctx.Header("WWW-Authenticate", `Bearer realm="ServeBin"`)
+		helper.NewError(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.BearerResponse{Authenticated: true, Token: token})
+}
+
+// DigestAuth	 	ServeBin
+// @Tags			Auth
+// @Summary			Prompts the user for authorization using HTTP Digest Auth.
+// @Description		Challenges the client with the given qop and algorithm (MD5 by default), a stale nonce is answered with stale=true.
+// @Param        	qop  		path  	string  true  	"auth or auth-int"
+// @Param        	user  		path  	string  true  	"User"
+// @Param        	passwd  	path  	string  true  	"Password"
+// @Param        	algorithm  	path  	string  false  	"MD5, SHA-256, MD5-sess or SHA-256-sess"
+// @Success			200			{object}	response.AuthResponse
+// @Failure      	400  		{object}  	response.HTTPError
+// @Failure      	401  		{object}  	response.HTTPError
+// @Router			/digest-auth/{qop}/{user}/{passwd} 	[get]
+// @Router			/digest-auth/{qop}/{user}/{passwd}/{algorithm} 	[get]
+func (controller *APIController) DigestAuth(ctx *gin.Context) {
+	req := request.DigestAuthRequest{
+		Qop:       ctx.Param("qop"),
+		User:      ctx.Param("user"),
+		Passwd:    ctx.Param("passwd"),
+		Algorithm: ctx.Param("algorithm"),
+	}
+	if req.Algorithm == "" {
+		req.Algorithm = "MD5"
+	}
+
+	err := controller.apiService.ValidateDigestAuth(ctx, req)
+
+	var validationErrors validator.ValidationErrors
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, response.AuthResponse{Authenticated: true, User: req.User})
+	case errors.As(err, &validationErrors):
+		helper.NewError(ctx, http.StatusBadRequest, err)
+	default:
+		stale := errors.Is(err, service.ErrStaleNonce)
+		ctx.Header("WWW-Authenticate", controller.apiService.DigestChallenge(req, stale))
+		helper.NewError(ctx, http.StatusUnauthorized, err)
+	}
+}
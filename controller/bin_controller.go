@@ -0,0 +1,114 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/helper"
+	"ServeBin/service"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"net/http"
+)
+
+// CreateBin	 	ServeBin
+// @Tags			Request bins
+// @Summary			Creates a new request bin.
+// @Description		Creates a bin which records every request sent to /bins/{id}/*.
+// @Success			201			{object}	response.BinResponse
+// @Failure      	507  		{object}  	response.HTTPError
+// @Failure      	500  		{object}  	response.HTTPError
+// @Router			/bins 		[post]
+func (controller *APIController) CreateBin(ctx *gin.Context) {
+	webResponse, err := controller.apiService.CreateBin()
+	if err != nil {
+		controller.binError(ctx, err)
+		return
+	}
+
+	binURL := controller.baseURL(ctx) + "/bins/" + webResponse.ID
+	webResponse.Url = binURL
+	webResponse.RequestsUrl = binURL + "/requests"
+
+	ctx.JSON(http.StatusCreated, webResponse)
+}
+
+// BinRequest	 	ServeBin
+// @Tags			Request bins
+// @Summary			Records the request in the bin.
+// @Description		Records any request sent to the bin. GET /bins/{id}/requests lists the recorded requests, newest first.
+// @Param        	id  		path  	string  true  	"Bin ID"
+// @Param        	page  		query  	int  	false  	"Page"
+// @Param        	limit  		query  	int  	false  	"Requests per page (max 100)"
+// @Success			200			{object}	response.BinRequestResponse
+// @Success			200			{object}	response.BinRequestsResponse
+// @Failure      	400  		{object}  	response.HTTPError
+// @Failure      	404  		{object}  	response.HTTPError
+// @Failure      	413  		{object}  	response.HTTPError
+// @Failure      	500  		{object}  	response.HTTPError
+// @Router			/bins/{id} 				[post]
+// @Router			/bins/{id}/requests 	[get]
+func (controller *APIController) BinRequest(ctx *gin.Context) {
+	binID := ctx.Param("id")
+
+	if ctx.Request.Method == http.MethodGet && ctx.Param("path") == "/requests" {
+		controller.listBinRequests(ctx, binID)
+		return
+	}
+
+	// Don't buffer more than the bin records
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, controller.config.Storage.MaxRequestBytes)
+
+	data, err := controller.bodyDataResponse(ctx)
+	if err != nil {
+		controller.bodyError(ctx, err)
+		return
+	}
+
+	webResponse, err := controller.apiService.RecordBinRequest(binID, data)
+	if err != nil {
+		controller.binError(ctx, err)
+		return
+	}
+	controller.apiService.PublishRequest(binID, ctx.Request.URL.Path, webResponse.BodyDataResponse, false)
+
+	ctx.JSON(http.StatusOK, webResponse)
+}
+
+// Lists the requests recorded in the bin
+func (controller *APIController) listBinRequests(ctx *gin.Context, binID string) {
+	req := request.BinRequestsRequest{Page: 1, Limit: 20}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	webResponse, err := controller.apiService.ListBinRequests(binID, req)
+	if err != nil {
+		controller.binError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webResponse)
+}
+
+// Sends the bin error with the matching status code
+func (controller *APIController) binError(ctx *gin.Context, err error) {
+	var validationErrors validator.ValidationErrors
+	switch {
+	case errors.Is(err, service.ErrBinNotFound):
+		helper.NewError(ctx, http.StatusNotFound, err)
+	case errors.Is(err, service.ErrStorageFull):
+		helper.NewError(ctx, http.StatusInsufficientStorage, err)
+	case errors.Is(err, service.ErrRequestTooLarge):
+		helper.NewError(ctx, http.StatusRequestEntityTooLarge, err)
+	case errors.As(err, &validationErrors):
+		helper.NewError(ctx, http.StatusBadRequest, err)
+	default:
+		helper.NewError(ctx, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/helper"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Last-Modified of the /range content, lets clients resume with a date in If-Range
+var rangeModTime = time.Now().UTC().Truncate(time.Second)
+
+// Bytes	 		ServeBin
+// @Tags			Dynamic data
+// @Summary			Returns n random bytes generated with the given seed.
+// @Description		Returns n random bytes, the same seed always generates the same bytes.
+// @produce			application/octet-stream
+// @Param        	n  			path  	int  	true  	"Number of bytes"
+// @Param        	seed  		query  	int  	false  	"Seed of the random generator"
+// @Success			200
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/bytes/{n} 	[get]
+func (controller *APIController) Bytes(ctx *gin.Context) {
+	n, err := strconv.Atoi(ctx.Param("n"))
+	if err != nil || n < 1 {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("n must be a positive integer"))
+		return
+	}
+
+	req := request.BytesRequest{N: n}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	reader, seed, err := controller.apiService.GenerateBytes(req)
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		helper.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.Header("X-Seed", strconv.FormatInt(seed, 10))
+	ctx.Data(http.StatusOK, "application/octet-stream", data)
+}
+
+// Range	 		ServeBin
+// @Tags			Dynamic data
+// @Summary			Streams n bytes, and allows specifying a Range header to select a subset of the data.
+// @Description		Honors Range (including multiple ranges), If-Range and conditional headers, answers 416 for unsatisfiable ranges.
+// @produce			application/octet-stream
+// @Param        	n  			path  	int  	true  	"Number of bytes"
+// @Param        	Range  		header  string  false  	"Byte ranges, e.g. bytes=0-9,20-"
+// @Success			200
+// @Success			206
+// @Failure      	400  		{object}  	response.HTTPError
+// @Failure      	416
+// @Router			/range/{n} 	[get]
+func (controller *APIController) Range(ctx *gin.Context) {
+	n, err := strconv.Atoi(ctx.Param("n"))
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("n must be a positive integer"))
+		return
+	}
+
+	content, err := controller.apiService.GenerateRangeContent(n)
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// ServeContent takes care of the ranges, If-Range and the 416 answers
+	ctx.Header("Content-Type", "application/octet-stream")
+	ctx.Header("ETag", fmt.Sprintf(`"range%d"`, n))
+	http.ServeContent(ctx.Writer, ctx.Request, "", rangeModTime, bytes.NewReader(content))
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/helper"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Cache	 		ServeBin
+// @Tags			Response inspection
+// @Summary			Returns a 304 if an If-Modified-Since header or If-None-Match is present. Returns the same as a GET otherwise.
+// @Description		Returns 304 when If-Modified-Since or If-None-Match is sent, otherwise the /get response with Last-Modified and ETag.
+// @Param        	If-Modified-Since  	header  string  false  	"Date"
+// @Param        	If-None-Match  		header  string  false  	"Entity tags"
+// @Success			200			{object}	response.EmptyResponse
+// @Success			304
+// @Router			/cache 		[get]
+func (controller *APIController) Cache(ctx *gin.Context) {
+	if ctx.GetHeader("If-Modified-Since") != "" || ctx.GetHeader("If-None-Match") != "" {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	etag := make([]byte, 16)
+	if _, err := rand.Read(etag); err != nil {
+		helper.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.Header("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+	ctx.Header("ETag", `"`+hex.EncodeToString(etag)+`"`)
+	ctx.JSON(http.StatusOK, controller.emptyResponse(ctx))
+}
+
+// CacheControl	 	ServeBin
+// @Tags			Response inspection
+// @Summary			Sets a Cache-Control header for n seconds.
+// @Description		Returns the /get response with Cache-Control: public, max-age=n.
+// @Param        	value  		path  	int  	true  	"max-age in seconds"
+// @Success			200			{object}	response.EmptyResponse
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/cache/{value} 	[get]
+func (controller *APIController) CacheControl(ctx *gin.Context) {
+	seconds, err := strconv.Atoi(ctx.Param("value"))
+	if err != nil || seconds < 0 {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("value must be a non-negative integer"))
+		return
+	}
+
+	ctx.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", seconds))
+	ctx.JSON(http.StatusOK, controller.emptyResponse(ctx))
+}
+
+// ETag	 			ServeBin
+// @Tags			Response inspection
+// @Summary			Assumes the resource has the given etag and responds to If-None-Match and If-Match headers appropriately.
+// @Description		If-None-Match is compared weakly and answers 304, If-Match is compared strongly and answers 412. The etag is weak when weak=true.
+// @Param        	etag  			path  	string  true  	"Entity tag of the resource"
+// @Param        	weak  			query  	bool  	false  	"Use a weak entity tag"
+// @Param        	If-None-Match  	header  string  false  	"Entity tags"
+// @Param        	If-Match  		header  string  false  	"Entity tags"
+// @Success			200			{object}	response.EmptyResponse
+// @Success			304
+// @Failure      	412
+// @Router			/etag/{etag} 	[get]
+func (controller *APIController) ETag(ctx *gin.Context) {
+	etag := `"` + ctx.Param("etag") + `"`
+	if ctx.Query("weak") == "true" {
+		etag = "W/" + etag
+	}
+	ctx.Header("ETag", etag)
+
+	switch status := controller.apiService.CheckETag(ctx, etag); status {
+	case http.StatusOK:
+		ctx.JSON(http.StatusOK, controller.emptyResponse(ctx))
+	default:
+		ctx.Status(status)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/data/response"
+	"ServeBin/helper"
+	"ServeBin/service"
+	"bytes"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Compressed	 	ServeBin
+// @Tags			Response formats
+// @Summary			Returns data compressed with the coding chosen by the Accept-Encoding header.
+// @Description		Picks br, zstd, gzip, deflate or identity following the Accept-Encoding q-values. The encoding query applies a list of codings in the given order, e.g. gzip,br is sent as Content-Encoding: gzip, br.
+// @produce			application/json
+// @Param        	Accept-Encoding	header  string  false  	"Accepted content codings"
+// @Param        	encoding  		query  	string  false  	"Comma separated codings to apply in order, overrides Accept-Encoding"
+// @Param        	level  			query  	int  	false  	"Compression level, 0 selects the default of each coding"
+// @Success			200				{object}	response.CompressedResponse
+// @Failure      	400				{object}	response.HTTPError
+// @Failure      	406				{object}	response.NotAcceptableError
+// @Failure      	500  			{object}  	response.HTTPError
+// @Router			/compressed 	[get]
+func (controller *APIController) Compressed(ctx *gin.Context) {
+	ctx.Header("Vary", "Accept-Encoding")
+
+	var req request.CompressedRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	encodings, err := controller.apiService.ChooseEncodings(ctx, req)
+	if errors.Is(err, service.ErrEncodingNotAcceptable) {
+		helper.NotAcceptable(ctx, helper.ContentEncodings)
+		return
+	} else if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	header := helper.GetHeaders(ctx)
+	ipResponse := controller.apiService.FindIP(ctx)
+
+	webResponse := response.CompressedResponse{
+		HeaderResponse: response.HeaderResponse{Header: header},
+		IPResponse:     response.IPResponse{IP: []interface{}{ipResponse}},
+		Encoding:       append([]string{}, encodings...),
+		Level:          req.Level,
+	}
+
+	// The first coding is applied first, so it wraps the writer last
+	var buf bytes.Buffer
+	writers := make([]io.WriteCloser, len(encodings))
+	var w io.Writer = &buf
+	for i := len(encodings) - 1; i >= 0; i-- {
+		writers[i], err = helper.NewEncoder(w, encodings[i], req.Level)
+		if err != nil {
+			helper.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+		w = writers[i]
+	}
+
+	if err := helper.WriteJSON(w, webResponse); err != nil {
+		helper.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	for _, writer := range writers {
+		if err := writer.Close(); err != nil {
+			helper.NewError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if len(encodings) > 0 {
+		ctx.Header("Content-Encoding", strings.Join(encodings, ", "))
+	}
+	ctx.Header("Content-Length", strconv.Itoa(buf.Len()))
+	ctx.Data(http.StatusOK, "application/json", buf.Bytes())
+}
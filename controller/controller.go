@@ -7,6 +7,7 @@ package controller
 
 import (
 	"ServeBin"
+	"ServeBin/config"
 	"ServeBin/data/response"
 	"ServeBin/helper"
 	"ServeBin/service"
@@ -14,18 +15,19 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"net/http"
-	"os"
 	"strings"
 	"time"
 )
 
 type APIController struct {
 	apiService service.APIService
+	config     *config.Config
 }
 
-func NewAPIController(service service.APIService) *APIController {
+func NewAPIController(service service.APIService, cfg *config.Config) *APIController {
 	return &APIController{
 		apiService: service,
+		config:     cfg,
 	}
 }
 
@@ -56,9 +58,20 @@ func (controller *APIController) HeartBeat(ctx *gin.Context) {
 	ctx.Data(http.StatusOK, "application/json", Response)
 }
 
+// Ready route handler, fails once the server is draining its connections so
+// that the load balancers stop sending it new requests
+func (controller *APIController) Ready(ctx *gin.Context) {
+	if controller.apiService.IsDraining() {
+		ctx.PureJSON(http.StatusServiceUnavailable, response.ReadinessResponse{Status: "Draining"})
+		return
+	}
+
+	ctx.PureJSON(http.StatusOK, response.ReadinessResponse{Status: "Ready"})
+}
+
 // Redirection for the backup servers
 func (controller *APIController) Redirect(ctx *gin.Context) {
-	mainServerUrl := os.Getenv("MAIN_SERVER")
+	mainServerUrl := controller.config.Server.MainServer
 	RedirectUrl := mainServerUrl + ctx.Request.URL.Path
 
 	ctx.Redirect(http.StatusTemporaryRedirect, RedirectUrl)
@@ -67,13 +80,7 @@ func (controller *APIController) Redirect(ctx *gin.Context) {
 // Function to generate sitemap.xml
 func (controller *APIController) GenerateSitemap(router *gin.Engine, ctx *gin.Context) {
 	// Get the base URL
-	var baseURL string
-	ssl := os.Getenv("IS_SSL")
-	if strings.ToLower(ssl) == "true" {
-		baseURL = "https://" + ctx.Request.Host
-	} else {
-		baseURL = "http://" + ctx.Request.Host
-	}
+	baseURL := controller.baseURL(ctx)
 
 	// Initialize the XML string
 	xmlStr := `<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:news="http://www.google.com/schemas/sitemap-news/0.9" xmlns:xhtml="http://www.w3.org/1999/xhtml" xmlns:mobile="http://www.google.com/schemas/sitemap-mobile/1.0" xmlns:image="http://www.google.com/schemas/sitemap-image/1.1" xmlns:video="http://www.google.com/schemas/sitemap-video/1.1">`
@@ -109,3 +116,11 @@ func (controller *APIController) GenerateSitemap(router *gin.Engine, ctx *gin.Co
 	ctx.Header("Content-Type", "text/xml; charset=utf-8")
 	ctx.String(http.StatusOK, "%s", xmlStr)
 }
+
+// Returns the scheme and host the server is reachable at
+func (controller *APIController) baseURL(ctx *gin.Context) string {
+	if ctx.Request.TLS != nil || controller.config.TLS.Enabled {
+		return "https://" + ctx.Request.Host
+	}
+	return "http://" + ctx.Request.Host
+}
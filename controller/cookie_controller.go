@@ -0,0 +1,99 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/data/response"
+	"ServeBin/helper"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+// GetCookies	 	ServeBin
+// @Tags			Cookies
+// @Summary			Returns cookie data.
+// @Description		Returns the cookies sent with the request.
+// @Success			200 		{object} 	response.CookiesResponse
+// @Router			/cookies 	[get]
+func (controller *APIController) GetCookies(ctx *gin.Context) {
+	webResponse := response.CookiesResponse{
+		Cookies: controller.apiService.ReturnCookies(ctx),
+	}
+	ctx.Header("Content-Type", "application/json")
+	ctx.JSON(http.StatusOK, webResponse)
+}
+
+// SetCookies	 	ServeBin
+// @Tags			Cookies
+// @Summary			Sets the cookies given in the query and redirects to /cookies.
+// @Description		Sets a cookie for every query parameter, e.g. /cookies/set?name=value.
+// @Param        	freeform  	query  	string  false  	"Cookie name and value"
+// @Success			302
+// @Router			/cookies/set 	[get]
+func (controller *APIController) SetCookies(ctx *gin.Context) {
+	for name, values := range ctx.Request.URL.Query() {
+		http.SetCookie(ctx.Writer, &http.Cookie{
+			Name:  name,
+			Value: values[0],
+			Path:  "/",
+		})
+	}
+	ctx.Redirect(http.StatusFound, "/cookies")
+}
+
+// DeleteCookies	ServeBin
+// @Tags			Cookies
+// @Summary			Deletes the cookies given in the query and redirects to /cookies.
+// @Description		Expires every cookie named in the query, e.g. /cookies/delete?name.
+// @Param        	freeform  	query  	string  false  	"Cookie name"
+// @Success			302
+// @Router			/cookies/delete 	[get]
+func (controller *APIController) DeleteCookies(ctx *gin.Context) {
+	for name := range ctx.Request.URL.Query() {
+		http.SetCookie(ctx.Writer, &http.Cookie{
+			Name:    name,
+			Path:    "/",
+			MaxAge:  -1,
+			Expires: time.Unix(0, 0),
+		})
+	}
+	ctx.Redirect(http.StatusFound, "/cookies")
+}
+
+// SetCookieWithAttributes	ServeBin
+// @Tags			Cookies
+// @Summary			Sets a cookie with the chosen attributes.
+// @Description		Sets a single cookie with the given Secure, HttpOnly, SameSite, Max-Age, Domain, Path and Partitioned attributes.
+// @Param        	name  			query  	string  true  	"Cookie name"
+// @Param        	value  			query  	string  false  	"Cookie value"
+// @Param        	secure  		query  	bool  	false  	"Secure"
+// @Param        	httponly  		query  	bool  	false  	"HttpOnly"
+// @Param        	samesite  		query  	string  false  	"SameSite: lax, strict or none"
+// @Param        	max_age  		query  	int  	false  	"Max-Age in seconds"
+// @Param        	domain  		query  	string  false  	"Domain"
+// @Param        	path  			query  	string  false  	"Path"
+// @Param        	partitioned  	query  	bool  	false  	"Partitioned"
+// @Success			200 		{object} 	response.SetCookieResponse
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/cookies/set-with-attributes 	[get]
+func (controller *APIController) SetCookieWithAttributes(ctx *gin.Context) {
+	var req request.CookieAttributesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	setCookie, err := controller.apiService.BuildCookie(req)
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.Writer.Header().Add("Set-Cookie", setCookie)
+	ctx.JSON(http.StatusOK, response.SetCookieResponse{SetCookie: setCookie})
+}
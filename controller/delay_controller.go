@@ -0,0 +1,66 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/helper"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+// Delay 			ServeBin
+// @Tags			Dynamic data
+// @Summary			Returns a delayed response.
+// @Description		Waits for the given number of seconds (capped by the server) before returning the request data.
+// @Param        	seconds  		path  	number  true  	"Delay in seconds"
+// @Param        	jitter  		query  	number  false  	"Jitter in seconds"
+// @Param        	distribution  	query  	string  false  	"Jitter distribution: uniform, normal or exponential"
+// @Success			200			{object}	response.BodyDataResponse
+// @Failure      	400  		{object}  	response.HTTPError
+// @Failure      	500  		{object}  	response.HTTPError
+// @Router			/delay/{seconds} 	[get]
+// @Router			/delay/{seconds} 	[post]
+// @Router			/delay/{seconds} 	[put]
+// @Router			/delay/{seconds} 	[patch]
+// @Router			/delay/{seconds} 	[delete]
+func (controller *APIController) Delay(ctx *gin.Context) {
+	seconds, err := strconv.ParseFloat(ctx.Param("seconds"), 64)
+	if err != nil || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("seconds must be a number"))
+		return
+	}
+
+	req := request.DelayRequest{Seconds: seconds, Distribution: "uniform"}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	delay, err := controller.apiService.ComputeDelay(req)
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// Read the request before waiting
+	webResponse, err := controller.bodyDataResponse(ctx)
+	if err != nil {
+		controller.bodyError(ctx, err)
+		return
+	}
+
+	// The client may be gone before the delay is over
+	if !sleepContext(ctx, delay) {
+		return
+	}
+
+	ctx.Header("X-Delay", strconv.FormatFloat(delay.Seconds(), 'f', 3, 64))
+	ctx.JSON(http.StatusOK, webResponse)
+}
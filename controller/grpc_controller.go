@@ -0,0 +1,189 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/config"
+	"ServeBin/data/request"
+	"ServeBin/pb"
+	"ServeBin/service"
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+	"time"
+)
+
+// Largest number of responses ServerStreamEcho sends
+const maxStreamEchoCount = 1000
+
+// GRPCController serves the ServeBin gRPC service
+type GRPCController struct {
+	pb.UnimplementedServeBinServer
+	apiService service.APIService
+	config     *config.Config
+}
+
+func NewGRPCController(service service.APIService, cfg *config.Config) *GRPCController {
+	return &GRPCController{
+		apiService: service,
+		config:     cfg,
+	}
+}
+
+// Echo implements pb.ServeBinServer
+func (controller *GRPCController) Echo(ctx context.Context, req *pb.EchoRequest) (*pb.EchoResponse, error) {
+	return &pb.EchoResponse{Message: req.Message, Payload: req.Payload}, nil
+}
+
+// ServerStreamEcho implements pb.ServeBinServer
+func (controller *GRPCController) ServerStreamEcho(req *pb.ServerStreamEchoRequest, stream pb.ServeBin_ServerStreamEchoServer) error {
+	count := req.Count
+	if count == 0 {
+		count = 10
+	}
+	if count < 0 || count > maxStreamEchoCount {
+		return status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", maxStreamEchoCount)
+	}
+
+	maxDelay := controller.config.Limits.MaxDelay.Duration
+	if req.Interval < 0 || req.Interval > maxDelay.Seconds() {
+		return status.Errorf(codes.InvalidArgument, "interval must be between 0 and %s", maxDelay)
+	}
+	interval := time.Duration(req.Interval * float64(time.Second))
+
+	for i := int32(1); i <= count; i++ {
+		if i > 1 {
+			if err := sleepGRPC(stream.Context(), interval); err != nil {
+				return err
+			}
+		}
+		if err := stream.Send(&pb.EchoResponse{Message: req.Message, Payload: req.Payload, Sequence: i}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientStreamEcho implements pb.ServeBinServer
+func (controller *GRPCController) ClientStreamEcho(stream pb.ServeBin_ClientStreamEchoServer) error {
+	var messages []*pb.EchoResponse
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&pb.ClientStreamEchoResponse{
+				Messages: messages,
+				Count:    int32(len(messages)),
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(messages) >= maxStreamEchoCount {
+			return status.Errorf(codes.ResourceExhausted, "at most %d messages are echoed", maxStreamEchoCount)
+		}
+		messages = append(messages, &pb.EchoResponse{
+			Message:  req.Message,
+			Payload:  req.Payload,
+			Sequence: int32(len(messages) + 1),
+		})
+	}
+}
+
+// BidiEcho implements pb.ServeBinServer
+func (controller *GRPCController) BidiEcho(stream pb.ServeBin_BidiEchoServer) error {
+	for sequence := int32(1); ; sequence++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(&pb.EchoResponse{Message: req.Message, Payload: req.Payload, Sequence: sequence}); err != nil {
+			return err
+		}
+	}
+}
+
+// Status implements pb.ServeBinServer
+func (controller *GRPCController) Status(ctx context.Context, req *pb.StatusRequest) (*emptypb.Empty, error) {
+	if req.Code > uint32(codes.Unauthenticated) {
+		return nil, status.Errorf(codes.InvalidArgument, "code must be between 0 and %d", codes.Unauthenticated)
+	}
+
+	code := codes.Code(req.Code)
+	if code == codes.OK {
+		return &emptypb.Empty{}, nil
+	}
+
+	message := req.Message
+	if message == "" {
+		message = code.String()
+	}
+	return nil, status.Error(code, message)
+}
+
+// Delay implements pb.ServeBinServer
+func (controller *GRPCController) Delay(ctx context.Context, req *pb.DelayRequest) (*pb.DelayResponse, error) {
+	delayRequest := request.DelayRequest{
+		Seconds:      req.Seconds,
+		Jitter:       req.Jitter,
+		Distribution: req.Distribution,
+	}
+	if delayRequest.Distribution == "" {
+		delayRequest.Distribution = "uniform"
+	}
+
+	delay, err := controller.apiService.ComputeDelay(delayRequest)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := sleepGRPC(ctx, delay); err != nil {
+		return nil, err
+	}
+	return &pb.DelayResponse{Delay: delay.Seconds()}, nil
+}
+
+// Metadata implements pb.ServeBinServer
+func (controller *GRPCController) Metadata(ctx context.Context, _ *emptypb.Empty) (*pb.MetadataResponse, error) {
+	webResponse := &pb.MetadataResponse{Metadata: make(map[string]*pb.MetadataValues)}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		webResponse.Metadata[key] = &pb.MetadataValues{Values: values}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		webResponse.Peer = p.Addr.String()
+	}
+
+	return webResponse, nil
+}
+
+// Waits for the duration, unless the RPC is cancelled first
+func sleepGRPC(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
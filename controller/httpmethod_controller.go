@@ -8,6 +8,7 @@ package controller
 import (
 	"ServeBin/data/response"
 	"ServeBin/helper"
+	"errors"
 	"github.com/gin-gonic/gin"
 	"net/http"
 )
@@ -25,6 +26,13 @@ import (
 // @Failure      		500  		{object}  	response.HTTPError
 // @Router				/get		[get]
 func (controller *APIController) ResponseData(ctx *gin.Context) {
+	webResponse := controller.emptyResponse(ctx)
+
+	ctx.JSON(http.StatusOK, webResponse)
+}
+
+// Extracts the request args, headers, origin, url and method
+func (controller *APIController) emptyResponse(ctx *gin.Context) response.EmptyResponse {
 	// Get URL parameters (args)
 	args, _ := controller.apiService.ReturnArguments(ctx)
 
@@ -40,15 +48,17 @@ func (controller *APIController) ResponseData(ctx *gin.Context) {
 	// Add method
 	method := ctx.Request.Method
 
-	webResponse := response.EmptyResponse{
-		ParamResponse:  response.ParamResponse{Parma: args},
-		HeaderResponse: response.HeaderResponse{Header: header},
-		IPResponse:     response.IPResponse{IP: []interface{}{origin}},
-		Url:            url,
-		Method:         method,
-	}
+	// Get protocol and TLS parameters
+	connection := controller.apiService.ReturnConnection(ctx)
 
-	ctx.JSON(http.StatusOK, webResponse)
+	return response.EmptyResponse{
+		ParamResponse:      response.ParamResponse{Parma: args},
+		HeaderResponse:     response.HeaderResponse{Header: header},
+		IPResponse:         response.IPResponse{IP: []interface{}{origin}},
+		ConnectionResponse: connection,
+		Url:                url,
+		Method:             method,
+	}
 }
 
 // ResponseHeaderData	ServeBin
@@ -90,23 +100,75 @@ func (controller *APIController) ResponseHeaderData(ctx *gin.Context) {
 // ResponseBodyData 	ServeBin
 // @Tags				HTTP Methods
 // @Summary				Returns the request parameters.
-// @Description			Returns different type of request parameters like form data, json data, raw data, headers etc.
+// @Description			Returns different type of request parameters like form data, json data, raw data, headers etc. Bodies sent with Content-Encoding gzip, deflate, br or zstd are decompressed first.
 // @Param        		body 			formData	string  false  "Body"
 // @Param        		formdata  		formData  	file  	false  "Form Data"
 // @Param        		customheader  	header  	string  false  "Header"
 // @Param        		queryparam  	query  		string  false  "Query Paramater"
 // @Default				200			{object}	response.BodyDataResponse
 // @Success				200			{object}	response.BodyDataResponse
-// @Failure      		400
+// @Failure      		400			{object}	response.HTTPError
 // @Failure      		404
+// @Failure      		413			{object}	response.HTTPError
+// @Failure      		415			{object}	response.HTTPError
 // @Failure      		500  		{object}  	response.HTTPError
 // @Router				/post		[post]
 // @Router				/put		[put]
 // @Router				/patch 		[patch]
 func (controller *APIController) ResponseBodyData(ctx *gin.Context) {
+	webResponse, err := controller.bodyDataResponse(ctx)
+	if err != nil {
+		controller.bodyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webResponse)
+}
+
+// Anything	 		ServeBin
+// @Tags				HTTP Methods
+// @Summary				Returns anything passed in the request.
+// @Description			Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.
+// @Param        		body 			formData	string  false  "Body"
+// @Param        		formdata  		formData  	file  	false  "Form Data"
+// @Param        		customheader  	header  	string  false  "Header"
+// @Param        		queryparam  	query  		string  false  "Query Paramater"
+// @Default				200			{object}	response.BodyDataResponse
+// @Success				200			{object}	response.BodyDataResponse
+// @Failure      		400
+// @Failure      		404
+// @Failure      		500  		{object}  	response.HTTPError
+// @Router				/anything		[get]
+// @Router				/anything		[post]
+// @Router				/anything		[put]
+// @Router				/anything		[patch]
+// @Router				/anything		[delete]
+// @Router				/anything/{path}	[get]
+// @Router				/anything/{path}	[post]
+// @Router				/anything/{path}	[put]
+// @Router				/anything/{path}	[patch]
+// @Router				/anything/{path}	[delete]
+func (controller *APIController) Anything(ctx *gin.Context) {
+	webResponse, err := controller.bodyDataResponse(ctx)
+	if err != nil {
+		controller.bodyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webResponse)
+}
+
+// Extracts every part of the request (args, form, files, body, headers etc.)
+func (controller *APIController) bodyDataResponse(ctx *gin.Context) (response.BodyDataResponse, error) {
 	// Get URL parameters (args)
 	args, _ := controller.apiService.ReturnArguments(ctx)
 
+	// Decompress the body as per Content-Encoding
+	bodySize, decodedBodySize, err := controller.apiService.DecodeRequestBody(ctx)
+	if err != nil {
+		return response.BodyDataResponse{}, err
+	}
+
 	// Initialized MultiPart Form Data
 	ctx.Request.ParseMultipartForm(10 << 20) // maxMemory 10 MB
 
@@ -131,17 +193,36 @@ func (controller *APIController) ResponseBodyData(ctx *gin.Context) {
 	// Add method
 	method := ctx.Request.Method
 
-	webResponse := response.BodyDataResponse{
-		ParamResponse:  response.ParamResponse{Parma: args},
-		DataResponse:   response.DataResponse{Data: rawData["rawData"]},
-		FileResponse:   response.FileResponse{File: files},
-		FormResponse:   response.FormResponse{Form: form},
-		HeaderResponse: response.HeaderResponse{Header: header},
-		JsonResponse:   response.JsonResponse{Json: rawData["json"]},
-		IPResponse:     response.IPResponse{IP: []interface{}{origin}},
-		Url:            url,
-		Method:         method,
-	}
+	// Get protocol and TLS parameters
+	connection := controller.apiService.ReturnConnection(ctx)
+
+	return response.BodyDataResponse{
+		ParamResponse:      response.ParamResponse{Parma: args},
+		DataResponse:       response.DataResponse{Data: rawData["rawData"]},
+		FileResponse:       response.FileResponse{File: files},
+		FormResponse:       response.FormResponse{Form: form},
+		HeaderResponse:     response.HeaderResponse{Header: header},
+		JsonResponse:       response.JsonResponse{Json: rawData["json"]},
+		IPResponse:         response.IPResponse{IP: []interface{}{origin}},
+		ConnectionResponse: connection,
+		BodySizeResponse: response.BodySizeResponse{
+			BodySize:        bodySize,
+			DecodedBodySize: decodedBodySize,
+		},
+		Url:    url,
+		Method: method,
+	}, nil
+}
 
-	ctx.JSON(http.StatusOK, webResponse)
+// Sends the error hit while reading the request body
+func (controller *APIController) bodyError(ctx *gin.Context, err error) {
+	var maxBytesError *http.MaxBytesError
+	switch {
+	case errors.Is(err, helper.ErrUnsupportedEncoding):
+		helper.NewError(ctx, http.StatusUnsupportedMediaType, err)
+	case errors.Is(err, helper.ErrBodyTooLarge), errors.As(err, &maxBytesError):
+		helper.NewError(ctx, http.StatusRequestEntityTooLarge, err)
+	default:
+		helper.NewError(ctx, http.StatusBadRequest, err)
+	}
 }
@@ -6,15 +6,40 @@
 package controller
 
 import (
+	"ServeBin/helper"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
+// An image type served by /image
+type imageFormat struct {
+	ContentType string
+	Extension   string
+	Generate    func() ([]byte, error)
+}
+
+// Lists the image formats, the first one is the default
+func (controller *APIController) imageFormats() []imageFormat {
+	return []imageFormat{
+		{"image/png", "png", controller.apiService.GeneratePNG},
+		{"image/jpeg", "jpeg", controller.apiService.GenerateJPEG},
+		{"image/svg+xml", "svg", controller.apiService.GenerateSVG},
+		{"image/gif", "gif", controller.apiService.GenerateGIF},
+		{"image/webp", "webp", controller.apiService.GenerateWEBP},
+		{"image/tiff", "tiff", controller.apiService.GenerateTIFF},
+		{"image/bmp", "bmp", controller.apiService.GenerateBMP},
+		{"image/apng", "apng", controller.apiService.GenerateAPNG},
+		{"image/avif", "avif", controller.apiService.GenerateAVIF},
+		{"image/x-icon", "ico", controller.apiService.GenerateICO},
+	}
+}
+
 // GetImages 	 	ServeBin
 // @Tags			Images
 // @Summary			Returns image as per the Accept header.
-// @Description		Returns a simple image of the type suggest by the Accept header.
+// @Description		Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.
 // @produce			image/png
 // @produce			image/jpeg
 // @produce			image/svg
@@ -27,7 +52,7 @@ import (
 // @produce			image/x-icon
 // @produce			image/*
 // @Success			200
-// @Failure      	406
+// @Failure      	406			{object}	response.NotAcceptableError
 // @Failure      	404
 // @Failure      	500  		{object}  	response.HTTPError
 // @Router			/image 		[get]
@@ -42,338 +67,57 @@ import (
 // @Router			/image/avif	[get]
 // @Router			/image/ico 	[get]
 func (controller *APIController) GetImages(ctx *gin.Context) {
-
-	var imageBytes []byte
-	var err error
-
-	switch imagetype := ctx.Param("imagetype"); imagetype {
-	case "":
-		switch accept := ctx.Request.Header["Accept"][0]; accept {
-		case "image/png":
-			// Generate PNG
-			imageBytes, err = controller.apiService.GeneratePNG()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate PNG: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/png")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.png")
-			break
-
-		case "image/svg+xml":
-			// Generate SVG
-			imageBytes, err = controller.apiService.GenerateSVG()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate SVG: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/svg+xml")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.svg")
-			break
-
-		case "image/gif":
-			// Generate GIF
-			imageBytes, err = controller.apiService.GenerateGIF()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate GIF: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/gif")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.gif")
-			break
-
-		case "image/jpeg":
-			// Generate JPEG
-			imageBytes, err = controller.apiService.GenerateJPEG()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate JPEG: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/jpeg")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.jpeg")
-			break
-
-		case "image/webp":
-			// Generate WEBP
-			imageBytes, err = controller.apiService.GenerateWEBP()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate WEBP: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/webp")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.webp")
-			break
-
-		case "image/tiff":
-			// Generate TIFF
-			imageBytes, err = controller.apiService.GenerateTIFF()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate TIFF: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/tiff")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.tiff")
-			break
-
-		case "image/bmp":
-			// Generate BMP
-			imageBytes, err = controller.apiService.GenerateBMP()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate BMP: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/bmp")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.bmp")
-			break
-
-		case "image/apng":
-			// Generate APNG
-			imageBytes, err = controller.apiService.GenerateAPNG()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate APNG: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/apng")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.apng")
-			break
-
-		case "image/avif":
-			// Generate AVIF
-			imageBytes, err = controller.apiService.GenerateAVIF()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate AVIF: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/avif")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.avif")
-			break
-
-		case "image/x-icon":
-			// Generate ICO
-			imageBytes, err = controller.apiService.GenerateICO()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate ICO: "+err.Error())
-				return
-			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/x-icon")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.ico")
-			break
-
-		default:
-			// Generate PNG
-			imageBytes, err = controller.apiService.GeneratePNG()
-			if err != nil {
-				ctx.String(http.StatusInternalServerError, "Failed to generate PNG: "+err.Error())
-				return
+	formats := controller.imageFormats()
+
+	var format *imageFormat
+	if imagetype := ctx.Param("imagetype"); imagetype != "" {
+		for i := range formats {
+			if formats[i].Extension == imagetype {
+				format = &formats[i]
+				break
 			}
-
-			// Set Content-Type header
-			ctx.Header("Content-Type", "image/png")
-
-			// Set Content-Disposition header
-			ctx.Header("Content-Disposition", "inline; filename=image.png")
-			break
-		}
-		break
-
-	case "png":
-		// Generate PNG
-		imageBytes, err = controller.apiService.GeneratePNG()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate PNG: "+err.Error())
-			return
-		}
-
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/png")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.png")
-		break
-
-	case "svg":
-		// Generate SVG
-		imageBytes, err = controller.apiService.GenerateSVG()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate SVG: "+err.Error())
-			return
-		}
-
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/svg+xml")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.svg")
-		break
-
-	case "gif":
-		// Generate GIF
-		imageBytes, err = controller.apiService.GenerateGIF()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate GIF: "+err.Error())
-			return
 		}
-
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/gif")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.gif")
-		break
-
-	case "jpeg":
-		// Generate JPEG
-		imageBytes, err = controller.apiService.GenerateJPEG()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate JPEG: "+err.Error())
+		if format == nil {
+			ctx.Status(http.StatusNotAcceptable)
 			return
 		}
+	} else {
+		ctx.Header("Vary", "Accept")
 
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/jpeg")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.jpeg")
-		break
-
-	case "webp":
-		// Generate WEBP
-		imageBytes, err = controller.apiService.GenerateWEBP()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate WEBP: "+err.Error())
-			return
+		offers := make([]string, len(formats))
+		for i, f := range formats {
+			offers[i] = f.ContentType
 		}
 
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/webp")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.webp")
-		break
-
-	case "tiff":
-		// Generate TIFF
-		imageBytes, err = controller.apiService.GenerateTIFF()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate TIFF: "+err.Error())
+		contentType, ok := helper.NegotiateContentType(ctx.GetHeader("Accept"), offers)
+		if !ok {
+			helper.NotAcceptable(ctx, offers)
 			return
 		}
-
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/tiff")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.tiff")
-		break
-
-	case "bmp":
-		// Generate BMP
-		imageBytes, err = controller.apiService.GenerateBMP()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate BMP: "+err.Error())
-			return
-		}
-
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/bmp")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.bmp")
-		break
-
-	case "apng":
-		// Generate APNG
-		imageBytes, err = controller.apiService.GenerateAPNG()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate APNG: "+err.Error())
-			return
-		}
-
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/apng")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.apng")
-		break
-
-	case "avif":
-		// Generate AVIF
-		imageBytes, err = controller.apiService.GenerateAVIF()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate AVIF: "+err.Error())
-			return
-		}
-
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/avif")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.avif")
-		break
-
-	case "ico":
-		// Generate ICO
-		imageBytes, err = controller.apiService.GenerateICO()
-		if err != nil {
-			ctx.String(http.StatusInternalServerError, "Failed to generate ICO: "+err.Error())
-			return
+		for i := range formats {
+			if formats[i].ContentType == contentType {
+				format = &formats[i]
+				break
+			}
 		}
+	}
 
-		// Set Content-Type header
-		ctx.Header("Content-Type", "image/x-icon")
-
-		// Set Content-Disposition header
-		ctx.Header("Content-Disposition", "inline; filename=image.ico")
-		break
-
-	default:
-		ctx.Status(http.StatusNotAcceptable)
+	// Generate the image
+	imageBytes, err := format.Generate()
+	if err != nil {
+		ctx.String(http.StatusInternalServerError, "Failed to generate "+strings.ToUpper(format.Extension)+": "+err.Error())
 		return
 	}
 
+	// Set Content-Type header
+	ctx.Header("Content-Type", format.ContentType)
+
+	// Set Content-Disposition header
+	ctx.Header("Content-Disposition", "inline; filename=image."+format.Extension)
+
 	// Set Content-Length header
 	ctx.Header("Content-Length", strconv.Itoa(len(imageBytes)))
 
-	// Write JPEG to response body
+	// Write image to response body
 	ctx.Writer.Write(imageBytes)
 }
@@ -0,0 +1,222 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/helper"
+	"ServeBin/service"
+	"bytes"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// Interval of the keep-alive messages sent on idle streams
+	inspectKeepAlive = 15 * time.Second
+
+	// Time allowed to write a message to the WebSocket
+	inspectWriteWait = 10 * time.Second
+
+	// Largest body published to the subscribers, longer ones are truncated
+	inspectMaxBody = 64 * 1024
+)
+
+var inspectUpgrader = websocket.Upgrader{
+	// ServeBin is meant to be called from any origin
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// Inspect is the middleware publishing the requests hitting a watched path
+func (controller *APIController) Inspect(ctx *gin.Context) {
+	path := ctx.Request.URL.Path
+	if path == "/inspect" || strings.HasPrefix(path, "/inspect/") || !controller.apiService.IsPathWatched(path) {
+		ctx.Next()
+		return
+	}
+
+	// Buffer the beginning of the body so that the handler can read it once again
+	original := ctx.Request.Body
+	body, err := io.ReadAll(io.LimitReader(original, inspectMaxBody+1))
+	if err != nil {
+		ctx.Next()
+		return
+	}
+
+	published := body
+	truncated := len(body) > inspectMaxBody
+	if truncated {
+		published = body[:inspectMaxBody]
+	}
+
+	contentLength := ctx.Request.ContentLength
+	ctx.Request.Body = io.NopCloser(bytes.NewReader(published))
+	ctx.Request.ContentLength = int64(len(published))
+
+	data, err := controller.bodyDataResponse(ctx)
+
+	// Hand an untouched request over to the handler, the rest of the body
+	// is still to be read from the client
+	ctx.Request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), original), original}
+	ctx.Request.ContentLength = contentLength
+	ctx.Request.MultipartForm = nil
+	ctx.Request.PostForm = nil
+	ctx.Request.Form = nil
+
+	// The handler reports bodies which can't be decoded, unless the
+	// truncation made them undecodable
+	if err == nil {
+		controller.apiService.PublishRequest("", path, data, truncated)
+	}
+
+	ctx.Next()
+}
+
+// Subscribes to the bin or path given in the query
+func (controller *APIController) subscribe(ctx *gin.Context) (*service.Subscription, bool) {
+	bin := ctx.Query("bin")
+	path := ctx.Query("path")
+
+	if bin == "" && path == "" {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("bin or path is required"))
+		return nil, false
+	}
+
+	if bin != "" {
+		// Make sure that the bin exists
+		_, err := controller.apiService.ListBinRequests(bin, request.BinRequestsRequest{Page: 1, Limit: 1})
+		if err != nil {
+			controller.binError(ctx, err)
+			return nil, false
+		}
+	} else if !strings.HasPrefix(path, "/") {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("path must start with /"))
+		return nil, false
+	}
+
+	return controller.apiService.SubscribeRequests(bin, path), true
+}
+
+// InspectStream 	ServeBin
+// @Tags			Request inspection
+// @Summary			Streams the inspected requests as Server-Sent Events.
+// @Description		Sends a "request" event for every request hitting the watched bin or path.
+// @produce			text/event-stream
+// @Param        	bin  		query  	string  false  	"Bin ID"
+// @Param        	path  		query  	string  false  	"Path prefix to watch, required without bin"
+// @Success			200			{object}	response.InspectEventResponse
+// @Failure      	400  		{object}  	response.HTTPError
+// @Failure      	404  		{object}  	response.HTTPError
+// @Router			/inspect/stream 	[get]
+func (controller *APIController) InspectStream(ctx *gin.Context) {
+	subscription, ok := controller.subscribe(ctx)
+	if !ok {
+		return
+	}
+	defer controller.apiService.UnsubscribeRequests(subscription)
+
+	keepAlive := time.NewTicker(inspectKeepAlive)
+	defer keepAlive.Stop()
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.SSEvent("subscribed", gin.H{"bin": ctx.Query("bin"), "path": ctx.Query("path")})
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-subscription.Events:
+			if !ok {
+				if subscription.Dropped {
+					ctx.SSEvent("dropped", "too slow to keep up with the requests")
+				}
+				return false
+			}
+			ctx.SSEvent("request", event)
+			return true
+
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+
+		case <-ctx.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+// InspectWebSocket ServeBin
+// @Tags			Request inspection
+// @Summary			Streams the inspected requests over a WebSocket.
+// @Description		Sends a JSON message for every request hitting the watched bin or path.
+// @Param        	bin  		query  	string  false  	"Bin ID"
+// @Param        	path  		query  	string  false  	"Path prefix to watch, required without bin"
+// @Success			101			{object}	response.InspectEventResponse
+// @Failure      	400  		{object}  	response.HTTPError
+// @Failure      	404  		{object}  	response.HTTPError
+// @Router			/inspect/ws 	[get]
+func (controller *APIController) InspectWebSocket(ctx *gin.Context) {
+	subscription, ok := controller.subscribe(ctx)
+	if !ok {
+		return
+	}
+	defer controller.apiService.UnsubscribeRequests(subscription)
+
+	conn, err := inspectUpgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		// Upgrade already replied with the error
+		return
+	}
+	defer conn.Close()
+
+	// Keep reading so that the control frames get processed
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	keepAlive := time.NewTicker(inspectKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event, ok := <-subscription.Events:
+			if !ok {
+				message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+				if subscription.Dropped {
+					message = websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "too slow to keep up with the requests")
+				}
+				conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(inspectWriteWait))
+				return
+			}
+
+			conn.SetWriteDeadline(time.Now().Add(inspectWriteWait))
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+
+		case <-keepAlive.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(inspectWriteWait)); err != nil {
+				return
+			}
+
+		case <-closed:
+			return
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/helper"
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"html/template"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Content types served by /negotiate, the first one is the default
+var negotiateFormats = []string{
+	"application/json",
+	"application/xml",
+	"text/xml",
+	"text/html",
+	"application/yaml",
+	"application/x-yaml",
+	"text/yaml",
+	"application/msgpack",
+	"application/x-msgpack",
+	"application/cbor",
+	"text/plain",
+}
+
+var negotiateHTML = template.Must(template.New("negotiate").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <title>ServeBin</title>
+</head>
+<body>
+    <pre>{{ . }}</pre>
+</body>
+</html>
+`))
+
+// Negotiate	 	ServeBin
+// @Tags			Response formats
+// @Summary			Returns the request data in the format chosen by the Accept header.
+// @Description		Returns the /get response as JSON, XML, HTML, YAML, MessagePack, CBOR or plain text following the Accept header q-values and wildcards. Answers 406 with the available types when none is acceptable.
+// @produce			application/json
+// @produce			application/xml
+// @produce			text/html
+// @produce			application/yaml
+// @produce			application/msgpack
+// @produce			application/cbor
+// @produce			text/plain
+// @Param        	Accept  	header  string  false  	"Accepted content types"
+// @Success			200			{object}	response.EmptyResponse
+// @Failure      	406			{object}	response.NotAcceptableError
+// @Failure      	500  		{object}  	response.HTTPError
+// @Router			/negotiate 	[get]
+func (controller *APIController) Negotiate(ctx *gin.Context) {
+	ctx.Header("Vary", "Accept")
+
+	contentType, ok := helper.NegotiateContentType(ctx.GetHeader("Accept"), negotiateFormats)
+	if !ok {
+		helper.NotAcceptable(ctx, negotiateFormats)
+		return
+	}
+
+	webResponse := controller.emptyResponse(ctx)
+
+	var buf bytes.Buffer
+	var err error
+	switch contentType {
+	case "application/json":
+		err = helper.WriteJSON(&buf, webResponse)
+	case "application/xml", "text/xml":
+		err = helper.WriteXML(&buf, webResponse)
+	case "text/html":
+		err = writeIndentedJSON(&buf, webResponse, func(w io.Writer, text string) error {
+			return negotiateHTML.Execute(w, text)
+		})
+	case "application/yaml", "application/x-yaml", "text/yaml":
+		err = helper.WriteYAML(&buf, webResponse)
+	case "application/msgpack", "application/x-msgpack":
+		err = helper.WriteMsgPack(&buf, webResponse)
+	case "application/cbor":
+		err = helper.WriteCBOR(&buf, webResponse)
+	case "text/plain":
+		err = writeIndentedJSON(&buf, webResponse, func(w io.Writer, text string) error {
+			_, err := io.WriteString(w, text)
+			return err
+		})
+	}
+	if err != nil {
+		helper.NewError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	if strings.HasPrefix(contentType, "text/") {
+		contentType += "; charset=utf-8"
+	}
+	ctx.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+// Indents the JSON of the value and hands it over to write
+func writeIndentedJSON(w io.Writer, v interface{}, write func(w io.Writer, text string) error) error {
+	data, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	return write(w, string(data)+"\n")
+}
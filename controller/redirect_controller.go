@@ -0,0 +1,126 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/helper"
+	"ServeBin/service"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// Parses the number of redirects left from the path
+func redirectCount(ctx *gin.Context) (int, bool) {
+	n, err := strconv.Atoi(ctx.Param("n"))
+	if err != nil || n < 1 {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("n must be a positive integer"))
+		return 0, false
+	}
+	return n, true
+}
+
+// Sends the redirect, gin's ctx.Redirect refuses the less common 3xx codes
+func redirect(ctx *gin.Context, status int, location string) {
+	ctx.Header("Location", location)
+	ctx.Status(status)
+}
+
+// RedirectN	 	ServeBin
+// @Tags			Redirects
+// @Summary			302 Redirects n times.
+// @Description		Redirects n times before landing on /get, absolute redirects are used when absolute=true.
+// @Param        	n  			path  	int  	true  	"Number of redirects"
+// @Param        	absolute  	query  	bool  	false  	"Use absolute redirects"
+// @Success			302
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/redirect/{n} 	[get]
+func (controller *APIController) RedirectN(ctx *gin.Context) {
+	if ctx.Query("absolute") == "true" {
+		controller.AbsoluteRedirect(ctx)
+		return
+	}
+	controller.RelativeRedirect(ctx)
+}
+
+// RelativeRedirect ServeBin
+// @Tags			Redirects
+// @Summary			Relatively 302 Redirects n times.
+// @Description		Redirects n times using relative Location headers before landing on /get.
+// @Param        	n  			path  	int  	true  	"Number of redirects"
+// @Success			302
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/relative-redirect/{n} 	[get]
+func (controller *APIController) RelativeRedirect(ctx *gin.Context) {
+	n, ok := redirectCount(ctx)
+	if !ok {
+		return
+	}
+
+	location := "/get"
+	if n > 1 {
+		location = "/relative-redirect/" + strconv.Itoa(n-1)
+	}
+
+	redirect(ctx, http.StatusFound, location)
+}
+
+// AbsoluteRedirect ServeBin
+// @Tags			Redirects
+// @Summary			Absolutely 302 Redirects n times.
+// @Description		Redirects n times using absolute Location headers before landing on /get.
+// @Param        	n  			path  	int  	true  	"Number of redirects"
+// @Success			302
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/absolute-redirect/{n} 	[get]
+func (controller *APIController) AbsoluteRedirect(ctx *gin.Context) {
+	n, ok := redirectCount(ctx)
+	if !ok {
+		return
+	}
+
+	location := controller.baseURL(ctx) + "/get"
+	if n > 1 {
+		location = controller.baseURL(ctx) + "/absolute-redirect/" + strconv.Itoa(n-1)
+	}
+
+	redirect(ctx, http.StatusFound, location)
+}
+
+// RedirectTo	 	ServeBin
+// @Tags			Redirects
+// @Summary			Redirects to the given URL.
+// @Description		Redirects to the given URL with any 3xx status code (302 by default). The server may restrict the hosts it redirects to.
+// @Param        	url  			query  	string  true  	"Redirect target"
+// @Param        	status_code  	query  	int  	false  	"3xx status code"
+// @Success			302
+// @Failure      	400  		{object}  	response.HTTPError
+// @Failure      	403  		{object}  	response.HTTPError
+// @Router			/redirect-to 	[get]
+// @Router			/redirect-to 	[post]
+// @Router			/redirect-to 	[put]
+// @Router			/redirect-to 	[patch]
+// @Router			/redirect-to 	[delete]
+func (controller *APIController) RedirectTo(ctx *gin.Context) {
+	req := request.RedirectToRequest{StatusCode: http.StatusFound}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := controller.apiService.ValidateRedirect(req); err != nil {
+		if errors.Is(err, service.ErrRedirectNotAllowed) {
+			helper.NewError(ctx, http.StatusForbidden, err)
+			return
+		}
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	redirect(ctx, req.StatusCode, req.Url)
+}
@@ -55,3 +55,20 @@ func (controller *APIController) GetUserAgent(ctx *gin.Context) {
 	ctx.Header("Content-Type", "application/json")
 	ctx.JSON(http.StatusOK, webResponse)
 }
+
+// GetClientCert 	ServeBin
+// @Tags			Request inspection
+// @Summary			Return the certificate chain presented by the client.
+// @Description		It returns the TLS client certificates (subject, issuer, SANs, serial, validity, fingerprints and key type) and whether they were verified against TLS_CLIENT_CA_FILE. The server asks for them as per TLS_CLIENT_AUTH.
+// @Success			200 {object} response.ClientCertResponse{}
+// @Failure      	400 {object} response.HTTPError
+// @Router			/client-cert [get]
+func (controller *APIController) GetClientCert(ctx *gin.Context) {
+	webResponse, err := controller.apiService.ReturnClientCert(ctx)
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webResponse)
+}
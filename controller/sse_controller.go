@@ -0,0 +1,103 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/data/response"
+	"ServeBin/helper"
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// SSE	 			ServeBin
+// @Tags			Dynamic data
+// @Summary			Streams Server-Sent Events.
+// @Description		Sends count events numbered from 1 with id, event and retry fields. A Last-Event-ID header resumes after the given event, 204 tells the client every event was delivered. drop_after cuts the connection to exercise the reconnection.
+// @produce			text/event-stream
+// @Param        	count  			query  	int  	false  	"Number of events (default 10)"
+// @Param        	interval  		query  	number  false  	"Seconds between the events (default 1)"
+// @Param        	event  			query  	string  false  	"Event type, message when empty"
+// @Param        	retry  			query  	int  	false  	"Reconnection time in milliseconds sent to the client"
+// @Param        	drop_after  	query  	int  	false  	"Drop the connection after sending this many events"
+// @Param        	payload  		query  	string  false  	"counter or request, the latter sends the /get response"
+// @Param        	Last-Event-ID  	header  string  false  	"ID of the last event received"
+// @Success			200				{object}	response.SSEEventResponse
+// @Success			204
+// @Failure      	400  			{object}  	response.HTTPError
+// @Router			/sse 			[get]
+func (controller *APIController) SSE(ctx *gin.Context) {
+	req := request.SSERequest{Count: 10, Interval: 1, Payload: "counter"}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	next, interval, err := controller.apiService.PlanSSE(req, ctx.GetHeader("Last-Event-ID"))
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// 204 stops EventSource from reconnecting
+	if next > req.Count {
+		ctx.Status(http.StatusNoContent)
+		return
+	}
+
+	webResponse := controller.emptyResponse(ctx)
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	for sent := 0; next <= req.Count; next++ {
+		if sent > 0 && !sleepContext(ctx, interval) {
+			return
+		}
+
+		var data interface{} = response.SSEEventResponse{
+			ID:        next,
+			Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		}
+		if req.Payload == "request" {
+			data = response.StreamResponse{ID: next, EmptyResponse: webResponse}
+		}
+
+		event := sse.Event{
+			Id:    strconv.Itoa(next),
+			Event: req.Event,
+			Data:  data,
+		}
+		// The reconnection time only needs to be sent once
+		if sent == 0 && req.Retry > 0 {
+			event.Retry = uint(req.Retry)
+		}
+
+		ctx.Render(-1, event)
+		ctx.Writer.Flush()
+		sent++
+
+		if req.DropAfter > 0 && sent >= req.DropAfter && next < req.Count {
+			dropConnection(ctx)
+			return
+		}
+	}
+}
+
+// Closes the connection without ending the response, so the client sees it dropped
+func dropConnection(ctx *gin.Context) {
+	conn, _, err := ctx.Writer.Hijack()
+	if err != nil {
+		// Connections which can't be hijacked (e.g. HTTP/2) end the stream instead
+		return
+	}
+	conn.Close()
+}
@@ -6,20 +6,20 @@
 package controller
 
 import (
+	"ServeBin/data/response"
 	"ServeBin/helper"
 	"github.com/gin-gonic/gin"
 	"net/http"
-	"strconv"
 )
 
 // GetStatusCodes 	ServeBin
 // @Tags			Status Codes
 // @Summary			Return status code or random status code if more than one are given.
-// @Description		Returns the requester's IP Address.
-// @Param        	statuscode   path  int  true  "Status Code"
+// @Description		Returns the given status code, or picks one at random from a list which may be weighted (e.g. 200:0.8,500:0.2). The headers required by the code (Location, WWW-Authenticate, Retry-After etc.) are set as well.
+// @Param        	statuscode   path  string  true  "Status Code"
 // @Default			200
-// @Success			200
-// @Failure      	400
+// @Success			200			{object}	response.Response
+// @Failure      	400			{object}  	response.HTTPError
 // @Failure      	404
 // @Failure      	500  	{object}  	response.HTTPError
 // @Router			/status/{statuscode} [get]
@@ -32,11 +32,44 @@ func (controller *APIController) GetStatusCodes(ctx *gin.Context) {
 	if statusCode == "" {
 		statusCode = "200"
 	}
-	status, err := strconv.ParseInt(statusCode, 10, 64)
+	status, err := controller.apiService.ChooseStatusCode(statusCode)
 	if err != nil {
-		helper.NewError(ctx, http.StatusNotFound, err)
+		helper.NewError(ctx, http.StatusBadRequest, err)
 		return
 	}
-	helper.ErrorPanic(err)
-	ctx.Status(int(status))
+
+	for key, value := range controller.apiService.ReturnStatusHeaders(status) {
+		ctx.Header(key, value)
+	}
+
+	// These codes never have a body
+	if status < 200 || status == http.StatusNoContent || status == http.StatusResetContent || status == http.StatusNotModified {
+		ctx.Status(status)
+		return
+	}
+
+	ctx.JSON(status, response.Response{
+		Code:   status,
+		Status: http.StatusText(status),
+	})
+}
+
+// ResponseHeaders 	ServeBin
+// @Tags			Response inspection
+// @Summary			Returns a set of response headers from the query string.
+// @Description		Sets every query parameter as a response header and returns them as JSON.
+// @Param        	freeform  	query  	string  false  	"Header name and value"
+// @Success			200
+// @Router			/response-headers [get]
+// @Router			/response-headers [post]
+func (controller *APIController) ResponseHeaders(ctx *gin.Context) {
+	for key, values := range ctx.Request.URL.Query() {
+		for _, value := range values {
+			ctx.Writer.Header().Add(key, value)
+		}
+	}
+
+	args, _ := controller.apiService.ReturnArguments(ctx)
+
+	ctx.JSON(http.StatusOK, args)
 }
@@ -0,0 +1,164 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/data/response"
+	"ServeBin/helper"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Largest number of lines /stream sends
+const maxStreamLines = 100
+
+// Stream	 		ServeBin
+// @Tags			Dynamic data
+// @Summary			Streams n JSON responses.
+// @Description		Streams n newline delimited copies of the /get response (at most 100).
+// @produce			application/x-ndjson
+// @Param        	n  			path  	int  	true  	"Number of lines"
+// @Success			200			{object}	response.StreamResponse
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/stream/{n} 	[get]
+func (controller *APIController) Stream(ctx *gin.Context) {
+	n, err := strconv.Atoi(ctx.Param("n"))
+	if err != nil || n < 1 {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("n must be a positive integer"))
+		return
+	}
+	n = min(n, maxStreamLines)
+
+	webResponse := controller.emptyResponse(ctx)
+
+	ctx.Header("Content-Type", "application/x-ndjson")
+	ctx.Status(http.StatusOK)
+
+	id := 0
+	ctx.Stream(func(w io.Writer) bool {
+		if err := helper.WriteJSON(w, response.StreamResponse{ID: id, EmptyResponse: webResponse}); err != nil {
+			return false
+		}
+		id++
+		return id < n
+	})
+}
+
+// Drip	 			ServeBin
+// @Tags			Dynamic data
+// @Summary			Drips data over a duration after an optional initial delay.
+// @Description		Sends numbytes bytes spread evenly over duration seconds, after waiting delay seconds, with the given status code.
+// @produce			application/octet-stream
+// @Param        	duration  	query  	number  false  	"Duration in seconds over which the data is sent"
+// @Param        	numbytes  	query  	int  	false  	"Number of bytes to send"
+// @Param        	delay  		query  	number  false  	"Initial delay in seconds"
+// @Param        	code  		query  	int  	false  	"Status code of the response"
+// @Success			200
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/drip 		[get]
+func (controller *APIController) Drip(ctx *gin.Context) {
+	req := request.DripRequest{Duration: 2, NumBytes: 10, Code: http.StatusOK}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	delay, interval, err := controller.apiService.PlanDrip(req)
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if !sleepContext(ctx, delay) {
+		return
+	}
+
+	ctx.Header("Content-Type", "application/octet-stream")
+	ctx.Header("Content-Length", strconv.Itoa(req.NumBytes))
+	ctx.Status(req.Code)
+
+	sent := 0
+	ctx.Stream(func(w io.Writer) bool {
+		if !sleepContext(ctx, interval) {
+			return false
+		}
+		if _, err := w.Write([]byte("*")); err != nil {
+			return false
+		}
+		sent++
+		return sent < req.NumBytes
+	})
+}
+
+// StreamBytes	 	ServeBin
+// @Tags			Dynamic data
+// @Summary			Streams n random bytes generated with the given seed in chunks.
+// @Description		Streams n random bytes in chunk_size chunks, the same seed always generates the same bytes.
+// @produce			application/octet-stream
+// @Param        	n  			path  	int  	true  	"Number of bytes"
+// @Param        	chunk_size  query  	int  	false  	"Size of the chunks"
+// @Param        	seed  		query  	int  	false  	"Seed of the random generator"
+// @Success			200
+// @Failure      	400  		{object}  	response.HTTPError
+// @Router			/stream-bytes/{n} 	[get]
+func (controller *APIController) StreamBytes(ctx *gin.Context) {
+	n, err := strconv.Atoi(ctx.Param("n"))
+	if err != nil || n < 1 {
+		helper.NewError(ctx, http.StatusBadRequest, errors.New("n must be a positive integer"))
+		return
+	}
+
+	req := request.StreamBytesRequest{N: n, ChunkSize: 10 * 1024}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	reader, seed, err := controller.apiService.StreamBytes(req)
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.Header("Content-Type", "application/octet-stream")
+	ctx.Header("X-Seed", strconv.FormatInt(seed, 10))
+	ctx.Status(http.StatusOK)
+
+	// No chunk is ever bigger than the payload
+	chunk := make([]byte, min(req.ChunkSize, req.N))
+	ctx.Stream(func(w io.Writer) bool {
+		read, err := io.ReadFull(reader, chunk)
+		if read > 0 {
+			if _, err := w.Write(chunk[:read]); err != nil {
+				return false
+			}
+		}
+		return err == nil
+	})
+}
+
+// Waits for the duration, returns false when the client went away meanwhile
+func sleepContext(ctx *gin.Context, duration time.Duration) bool {
+	if duration <= 0 {
+		return ctx.Request.Context().Err() == nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Request.Context().Done():
+		ctx.Abort()
+		return false
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/helper"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Largest message the echo server reads
+const echoReadLimit = 1 << 20
+
+// WebSocketEcho	ServeBin
+// @Tags			WebSocket
+// @Summary			Echoes the text and binary messages sent over a WebSocket.
+// @Description		Echoes every message with its type. The query options send pings, close the connection after some messages, delay the replies, split them into frames and negotiate subprotocols and permessage-deflate.
+// @Param        	ping_interval  	query  	number  false  	"Seconds between the pings sent by the server"
+// @Param        	close_after  	query  	int  	false  	"Close the connection after echoing this many messages"
+// @Param        	close_code  	query  	int  	false  	"Close code sent with close_after (default 1000)"
+// @Param        	close_reason  	query  	string  false  	"Close reason sent with close_after"
+// @Param        	delay  			query  	number  false  	"Seconds to wait before each reply"
+// @Param        	fragment  		query  	int  	false  	"Largest payload in bytes of each frame"
+// @Param        	subprotocols  	query  	string  false  	"Comma separated subprotocols the server accepts, by default the first one offered is accepted"
+// @Param        	compression  	query  	bool  	false  	"Negotiate permessage-deflate"
+// @Success			101
+// @Failure      	400  			{object}  	response.HTTPError
+// @Router			/ws/echo 		[get]
+func (controller *APIController) WebSocketEcho(ctx *gin.Context) {
+	req := request.WebSocketEchoRequest{CloseCode: websocket.CloseNormalClosure}
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	pingInterval, delay, err := controller.apiService.PlanWebSocketEcho(req)
+	if err != nil {
+		helper.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// A frame is sent whenever the write buffer is full, so its size sets the fragment size
+	upgrader := websocket.Upgrader{
+		CheckOrigin:       func(r *http.Request) bool { return true },
+		EnableCompression: req.Compression,
+		WriteBufferSize:   req.Fragment,
+	}
+	if req.Subprotocols != "" {
+		for _, protocol := range strings.Split(req.Subprotocols, ",") {
+			if protocol = strings.TrimSpace(protocol); protocol != "" {
+				upgrader.Subprotocols = append(upgrader.Subprotocols, protocol)
+			}
+		}
+	} else if offered := websocket.Subprotocols(ctx.Request); len(offered) > 0 {
+		upgrader.Subprotocols = offered[:1]
+	}
+
+	conn, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		// Upgrade already replied with the error
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(echoReadLimit)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	if pingInterval > 0 {
+		go func() {
+			ticker := time.NewTicker(pingInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(inspectWriteWait)); err != nil {
+						return
+					}
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	for count := 1; ; count++ {
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		if !sleepContext(ctx, delay) {
+			return
+		}
+
+		conn.SetWriteDeadline(time.Now().Add(inspectWriteWait))
+		if err := writeFragmented(conn, messageType, message, req.Fragment); err != nil {
+			return
+		}
+
+		if req.CloseAfter > 0 && count >= req.CloseAfter {
+			message := websocket.FormatCloseMessage(req.CloseCode, req.CloseReason)
+			if err := conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(inspectWriteWait)); err != nil {
+				return
+			}
+
+			// Give the client some time to acknowledge the close
+			conn.SetReadDeadline(time.Now().Add(inspectWriteWait))
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Writes the message split into frames carrying at most size bytes, 0 sends a single frame
+func writeFragmented(conn *websocket.Conn, messageType int, message []byte, size int) error {
+	if size <= 0 {
+		return conn.WriteMessage(messageType, message)
+	}
+
+	// Large writes skip the write buffer, so hand it over one fragment at a time
+	w, err := conn.NextWriter(messageType)
+	if err != nil {
+		return err
+	}
+	for len(message) > size {
+		if _, err := w.Write(message[:size]); err != nil {
+			return err
+		}
+		message = message[size:]
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	return w.Close()
+}
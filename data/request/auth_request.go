@@ -0,0 +1,13 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type DigestAuthRequest struct {
+	Qop       string `validate:"oneof=auth auth-int" json:"qop"`
+	User      string `validate:"required" json:"user"`
+	Passwd    string `validate:"required" json:"passwd"`
+	Algorithm string `validate:"oneof=MD5 SHA-256 MD5-sess SHA-256-sess" json:"algorithm"`
+}
@@ -0,0 +1,11 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type BinRequestsRequest struct {
+	Page  int `validate:"min=1,max=1000000" form:"page" json:"page"`
+	Limit int `validate:"min=1,max=100" form:"limit" json:"limit"`
+}
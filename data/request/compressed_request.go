@@ -0,0 +1,11 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type CompressedRequest struct {
+	Encoding string `form:"encoding" json:"encoding"`
+	Level    int    `validate:"min=0,max=22" form:"level" json:"level"`
+}
@@ -0,0 +1,18 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type CookieAttributesRequest struct {
+	Name        string `validate:"required" form:"name" json:"name"`
+	Value       string `form:"value" json:"value"`
+	Secure      bool   `form:"secure" json:"secure"`
+	HttpOnly    bool   `form:"httponly" json:"httponly"`
+	SameSite    string `validate:"omitempty,oneof=lax strict none Lax Strict None" form:"samesite" json:"samesite"`
+	MaxAge      *int   `form:"max_age" json:"max_age"`
+	Domain      string `form:"domain" json:"domain"`
+	Path        string `form:"path" json:"path"`
+	Partitioned bool   `form:"partitioned" json:"partitioned"`
+}
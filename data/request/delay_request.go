@@ -0,0 +1,12 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type DelayRequest struct {
+	Seconds      float64 `validate:"min=0" form:"-" json:"seconds"`
+	Jitter       float64 `validate:"min=0" form:"jitter" json:"jitter"`
+	Distribution string  `validate:"oneof=uniform normal exponential" form:"distribution" json:"distribution"`
+}
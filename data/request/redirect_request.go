@@ -0,0 +1,11 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type RedirectToRequest struct {
+	Url        string `validate:"required" form:"url" json:"url"`
+	StatusCode int    `validate:"min=300,max=399" form:"status_code" json:"status_code"`
+}
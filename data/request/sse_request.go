@@ -0,0 +1,15 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type SSERequest struct {
+	Count     int     `validate:"min=1,max=1000" form:"count" json:"count"`
+	Interval  float64 `validate:"min=0" form:"interval" json:"interval"`
+	Event     string  `validate:"max=64" form:"event" json:"event"`
+	Retry     int     `validate:"min=0" form:"retry" json:"retry"`
+	DropAfter int     `validate:"min=0" form:"drop_after" json:"drop_after"`
+	Payload   string  `validate:"oneof=counter request" form:"payload" json:"payload"`
+}
@@ -0,0 +1,24 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type DripRequest struct {
+	Duration float64 `validate:"min=0" form:"duration" json:"duration"`
+	NumBytes int     `validate:"min=1,max=10485760" form:"numbytes" json:"numbytes"`
+	Delay    float64 `validate:"min=0" form:"delay" json:"delay"`
+	Code     int     `validate:"min=100,max=599" form:"code" json:"code"`
+}
+
+type BytesRequest struct {
+	N    int    `validate:"min=1" form:"-" json:"n"`
+	Seed *int64 `form:"seed" json:"seed"`
+}
+
+type StreamBytesRequest struct {
+	N         int    `validate:"min=1" form:"-" json:"n"`
+	ChunkSize int    `validate:"min=1,max=10485760" form:"chunk_size" json:"chunk_size"`
+	Seed      *int64 `form:"seed" json:"seed"`
+}
@@ -0,0 +1,17 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package request
+
+type WebSocketEchoRequest struct {
+	PingInterval float64 `validate:"omitempty,min=0.1,max=3600" form:"ping_interval" json:"ping_interval"`
+	CloseAfter   int     `validate:"min=0" form:"close_after" json:"close_after"`
+	CloseCode    int     `validate:"min=1000,max=4999" form:"close_code" json:"close_code"`
+	CloseReason  string  `form:"close_reason" json:"close_reason"`
+	Delay        float64 `validate:"min=0" form:"delay" json:"delay"`
+	Fragment     int     `validate:"min=0,max=1048576" form:"fragment" json:"fragment"`
+	Subprotocols string  `form:"subprotocols" json:"subprotocols"`
+	Compression  bool    `form:"compression" json:"compression"`
+}
@@ -0,0 +1,16 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package response
+
+type AuthResponse struct {
+	Authenticated bool   `json:"authenticated" example:"true"`
+	User          string `json:"user" example:"user"`
+}
+
+type BearerResponse struct {
+	Authenticated bool   `json:"authenticated" example:"true"`
+	Token         string `json:"token" example:"token"`
+}
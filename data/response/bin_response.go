@@ -0,0 +1,29 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package response
+
+import "time"
+
+type BinResponse struct {
+	ID          string    `json:"id" example:"5f2b9c1d7e4a3b60"`
+	Url         string    `json:"url,omitempty"`
+	RequestsUrl string    `json:"requests_url,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type BinRequestResponse struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	BodyDataResponse
+}
+
+type BinRequestsResponse struct {
+	BinID    string               `json:"bin_id"`
+	Page     int                  `json:"page"`
+	Limit    int                  `json:"limit"`
+	Total    int                  `json:"total"`
+	Requests []BinRequestResponse `json:"requests"`
+}
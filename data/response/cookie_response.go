@@ -0,0 +1,14 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package response
+
+type CookiesResponse struct {
+	Cookies map[string]string `json:"cookies"`
+}
+
+type SetCookieResponse struct {
+	SetCookie string `json:"set-cookie" example:"session=abc; Path=/; HttpOnly; Secure; SameSite=Lax"`
+}
@@ -9,3 +9,8 @@ type HTTPError struct {
 	Code    int    `json:"code" example:"400"`
 	Message string `json:"message" example:"status bad request"`
 }
+
+type NotAcceptableError struct {
+	HTTPError
+	Available []string `json:"available" example:"application/json,application/xml"`
+}
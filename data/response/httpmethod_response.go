@@ -25,10 +25,22 @@ type JsonResponse struct {
 	Json interface{} `json:"json,omitempty"`
 }
 
+type BodySizeResponse struct {
+	BodySize        int64 `json:"body_size,omitempty"`
+	DecodedBodySize int64 `json:"decoded_body_size,omitempty"`
+}
+
+type ConnectionResponse struct {
+	Protocol    string `json:"protocol,omitempty" example:"HTTP/2.0"`
+	TLSVersion  string `json:"tls_version,omitempty" example:"TLS 1.3"`
+	CipherSuite string `json:"cipher_suite,omitempty" example:"TLS_AES_128_GCM_SHA256"`
+}
+
 type EmptyResponse struct {
 	ParamResponse
 	HeaderResponse
 	IPResponse
+	ConnectionResponse
 	Url    string `json:"url,omitempty"`
 	Method string `json:"method,omitempty"`
 }
@@ -41,6 +53,8 @@ type BodyDataResponse struct {
 	HeaderResponse
 	JsonResponse
 	IPResponse
+	ConnectionResponse
+	BodySizeResponse
 	Url    string `json:"url,omitempty"`
 	Method string `json:"method,omitempty"`
 }
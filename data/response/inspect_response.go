@@ -0,0 +1,16 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package response
+
+import "time"
+
+type InspectEventResponse struct {
+	Bin       string    `json:"bin,omitempty"`
+	Path      string    `json:"path"`
+	Timestamp time.Time `json:"timestamp"`
+	Truncated bool      `json:"truncated,omitempty"`
+	BodyDataResponse
+}
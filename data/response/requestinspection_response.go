@@ -16,3 +16,21 @@ type UserAgentResponse struct {
 type HeaderResponse struct {
 	Header map[string]string `json:"headers,omitempty"`
 }
+
+type ClientCertResponse struct {
+	Certificates []CertificateResponse `json:"client_certificates"`
+	Verified     bool                  `json:"verified" example:"true"`
+}
+
+type CertificateResponse struct {
+	Subject            string            `json:"subject" example:"CN=client,O=ServeBin"`
+	Issuer             string            `json:"issuer" example:"CN=ServeBin CA"`
+	SANs               []string          `json:"sans,omitempty" example:"DNS:client.local"`
+	SerialNumber       string            `json:"serial_number" example:"1f:2a:3b"`
+	NotBefore          string            `json:"not_before" example:"2024-01-01T00:00:00Z"`
+	NotAfter           string            `json:"not_after" example:"2025-01-01T00:00:00Z"`
+	Fingerprints       map[string]string `json:"fingerprints"`
+	KeyType            string            `json:"key_type" example:"ECDSA P-256"`
+	SignatureAlgorithm string            `json:"signature_algorithm" example:"ECDSA-SHA256"`
+	IsCA               bool              `json:"is_ca" example:"false"`
+}
@@ -24,6 +24,10 @@ type HeartbeatResponse struct {
 	Status string         `json:"status"`
 }
 
+type ReadinessResponse struct {
+	Status string `json:"status"`
+}
+
 type HeartbeatStats struct {
 	CPULoad                    float64        `json:"cpu_load"`
 	Disk                       DiskStats      `json:"disk"`
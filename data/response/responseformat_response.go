@@ -28,3 +28,10 @@ type ZstdResponse struct {
 	IPResponse
 	Compressed bool `json:"compressed"  example:"true"`
 }
+
+type CompressedResponse struct {
+	HeaderResponse
+	IPResponse
+	Encoding []string `json:"encoding"  example:"gzip,br"`
+	Level    int      `json:"level"  example:"0"`
+}
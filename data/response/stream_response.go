@@ -0,0 +1,16 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package response
+
+type StreamResponse struct {
+	ID int `json:"id" example:"0"`
+	EmptyResponse
+}
+
+type SSEEventResponse struct {
+	ID        int    `json:"id" example:"1"`
+	Timestamp string `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+}
@@ -7,10 +7,10 @@ const docTemplate = `{
     "schemes": {{ marshal .Schemes }},
     "openapi": "3.0.3",
     "info": {
-        "description": "{{escape .Description}}",
         "title": "{{.Title}}",
+        "description": "{{escape .Description}}",
         "termsOfService": "https://servebin.dev/",
-        "contact":  {
+        "contact": {
             "email": "contact@mrayush.me"
         },
         "license": {
@@ -22,17 +22,17 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "servers": [
-      {
-        "url": "https://servebin.dev/"
-      },
-      {
-        "url": "https://s1.servebin.dev/"
-      },
-      {
-        "url": "https://s2.servebin.dev/"
-      }
+        {
+            "url": "https://servebin.dev/"
+        },
+        {
+            "url": "https://s1.servebin.dev/"
+        },
+        {
+            "url": "https://s2.servebin.dev/"
+        }
     ],
-	"tags": [
+    "tags": [
         {
             "name": "Status Codes",
             "description": "Generates responses with given status code"
@@ -52,35 +52,61 @@ const docTemplate = `{
         {
             "name": "HTTP Methods",
             "description": "Testing different HTTP verbs"
+        },
+        {
+            "name": "Response inspection",
+            "description": "Inspect the response data like caching and headers"
+        },
+        {
+            "name": "Redirects",
+            "description": "Returns different redirect responses"
+        },
+        {
+            "name": "Auth",
+            "description": "Auth methods"
+        },
+        {
+            "name": "Cookies",
+            "description": "Creates, reads and deletes cookies"
+        },
+        {
+            "name": "Dynamic data",
+            "description": "Generates random and dynamic data"
+        },
+        {
+            "name": "Request bins",
+            "description": "Capture and replay inbound requests"
+        },
+        {
+            "name": "WebSocket",
+            "description": "Tests WebSocket clients"
         }
     ],
     "paths": {
-        "/brotli": {
+        "/absolute-redirect/{n}": {
             "get": {
                 "tags": [
-                    "Response formats"
+                    "Redirects"
                 ],
-                "summary": "Returns Brotli-encoded data.",
-                "description": "Returns Brotli-encoded data.",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "content": {
-                            "application/json": {
-                                "schema": {
-                                    "$ref": "#/components/schemas/response.BrotliResponse"
-                                }
-                            }
+                "summary": "Absolutely 302 Redirects n times.",
+                "description": "Redirects n times using absolute Location headers before landing on /get.",
+                "parameters": [
+                    {
+                        "name": "n",
+                        "in": "path",
+                        "description": "Number of redirects",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
                         }
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -92,30 +118,70 @@ const docTemplate = `{
                 }
             }
         },
-        "/deflate": {
+        "/anything": {
             "get": {
                 "tags": [
-                    "Response formats"
+                    "HTTP Methods"
                 ],
-                "summary": "Returns Deflate-encoded data.",
-                "description": "Returns Deflate-encoded data.",
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.DeflateResponse"
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
                                 }
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request"
+                    },
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "content": {
@@ -127,25 +193,70 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/deny": {
-            "get": {
+            },
+            "post": {
                 "tags": [
-                    "Response formats"
+                    "HTTP Methods"
                 ],
-                "summary": "Returns page denied by robots.txt rules.",
-                "description": "Returns page denied by robots.txt rules.",
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
                     },
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "content": {
@@ -157,15 +268,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/get": {
-            "get": {
+            },
+            "put": {
                 "tags": [
                     "HTTP Methods"
                 ],
-                "summary": "Returns the request parameters.",
-                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
                 "parameters": [
                     {
                         "name": "customheader",
@@ -184,29 +293,49 @@ const docTemplate = `{
                         }
                     }
                 ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
-                            "*/*": {
+                            "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
                                 }
                             }
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "content": {}
+                        "description": "Bad Request"
                     },
                     "404": {
-                        "description": "Not Found",
-                        "content": {}
+                        "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "content": {
-                            "*/*": {
+                            "application/json": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.HTTPError"
                                 }
@@ -214,37 +343,74 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/gzip": {
-            "get": {
+            },
+            "patch": {
                 "tags": [
-                    "Response formats"
+                    "HTTP Methods"
                 ],
-                "summary": "Returns GZip-encoded data.",
-                "description": "Returns GZip-encoded data.",
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.GzipResponse"
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
                                 }
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request"
+                    },
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable",
-                        "content": {}
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "content": {
-                            "text/plain": {
+                            "application/json": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.HTTPError"
                                 }
@@ -252,15 +418,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/head": {
-            "head": {
+            },
+            "delete": {
                 "tags": [
                     "HTTP Methods"
                 ],
-                "summary": "Returns the request parameters.",
-                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
                 "parameters": [
                     {
                         "name": "customheader",
@@ -269,20 +433,54 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "content": {}
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "content": {}
+                        "description": "Bad Request"
                     },
                     "404": {
-                        "description": "Not Found",
-                        "content": {}
+                        "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -297,44 +495,70 @@ const docTemplate = `{
                 }
             }
         },
-        "/headers": {
+        "/anything/{path}": {
             "get": {
                 "tags": [
-                    "Request inspection"
+                    "HTTP Methods"
                 ],
-                "summary": "Return the incoming request's HTTP headers.",
-                "description": "It returns the incoming request's HTTP headers.",
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.HeaderResponse"
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
                                 }
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/html": {
-            "get": {
-                "tags": [
-                    "Response formats"
-                ],
-                "summary": "Returns a simple HTML document.",
-                "description": "Returns a simple HTML document.",
-                "responses": {
-                    "200": {
-                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
                     },
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "content": {
@@ -346,132 +570,3992 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/image": {
-            "get": {
+            },
+            "post": {
                 "tags": [
-                    "Images"
+                    "HTTP Methods"
                 ],
-                "summary": "Returns image as per the Accept header.",
-                "description": "Returns a simple image of the type suggest by the Accept header.",
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
-                            "image/png": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/jpeg": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/svg+xml": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/gif": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/webp": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/tiff": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/bmp": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/apng": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/avif": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/x-icon": {
-                                "schema": {
-                                    "format": "binary"
-                                }
-                            },
-                            "image/*": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
                                 }
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request"
+                    },
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
                     "500": {
-                        "description": "Internal Server Error"
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     }
                 }
-            }
-        },
-        "/image/apng": {
-            "get": {
+            },
+            "put": {
                 "tags": [
-                    "Images"
+                    "HTTP Methods"
+                ],
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 ],
-                "summary": "Returns a simple APNG image.",
-                "description": "Returns a simple image of the APNG mime-type.",
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
-                            "image/apng": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
                                 }
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request"
+                    },
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
                     "500": {
-                        "description": "Internal Server Error"
-                    }
-                }
-            }
-        },
-        "/image/avif": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "tags": [
+                    "HTTP Methods"
+                ],
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "HTTP Methods"
+                ],
+                "summary": "Returns anything passed in the request.",
+                "description": "Returns every part of the request (args, form, files, data, json, headers, origin, url and method) for any HTTP verb, including custom ones like PROPFIND or QUERY.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/basic-auth/{user}/{passwd}": {
             "get": {
                 "tags": [
-                    "Images"
+                    "Auth"
+                ],
+                "summary": "Prompts the user for authorization using HTTP Basic Auth.",
+                "description": "Succeeds when the Basic credentials match the user and password in the path.",
+                "parameters": [
+                    {
+                        "name": "user",
+                        "in": "path",
+                        "description": "User",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "passwd",
+                        "in": "path",
+                        "description": "Password",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.AuthResponse"
+                                }
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bearer": {
+            "get": {
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Prompts the user for authorization using bearer authentication.",
+                "description": "Succeeds when any bearer token is sent in the Authorization header.",
+                "parameters": [
+                    {
+                        "name": "Authorization",
+                        "in": "header",
+                        "description": "Bearer token",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BearerResponse"
+                                }
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bins": {
+            "post": {
+                "tags": [
+                    "Request bins"
+                ],
+                "summary": "Creates a new request bin.",
+                "description": "Creates a bin which records every request sent to /bins/{id}/*.",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BinResponse"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "507": {
+                        "description": "Insufficient Storage",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bins/{id}": {
+            "post": {
+                "tags": [
+                    "Request bins"
                 ],
-                "summary": "Returns a simple AVIF image.",
-                "description": "Returns a simple image of the AVIF mime-type.",
+                "summary": "Records the request in the bin.",
+                "description": "Records any request sent to the bin. GET /bins/{id}/requests lists the recorded requests, newest first.",
+                "parameters": [
+                    {
+                        "name": "id",
+                        "in": "path",
+                        "description": "Bin ID",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "page",
+                        "in": "query",
+                        "description": "Page",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "limit",
+                        "in": "query",
+                        "description": "Requests per page (max 100)",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BinRequestsResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bins/{id}/requests": {
+            "get": {
+                "tags": [
+                    "Request bins"
+                ],
+                "summary": "Records the request in the bin.",
+                "description": "Records any request sent to the bin. GET /bins/{id}/requests lists the recorded requests, newest first.",
+                "parameters": [
+                    {
+                        "name": "id",
+                        "in": "path",
+                        "description": "Bin ID",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "page",
+                        "in": "query",
+                        "description": "Page",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "limit",
+                        "in": "query",
+                        "description": "Requests per page (max 100)",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BinRequestsResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/brotli": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns Brotli-encoded data.",
+                "description": "Returns Brotli-encoded data.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BrotliResponse"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bytes/{n}": {
+            "get": {
+                "tags": [
+                    "Dynamic data"
+                ],
+                "summary": "Returns n random bytes generated with the given seed.",
+                "description": "Returns n random bytes, the same seed always generates the same bytes.",
+                "parameters": [
+                    {
+                        "name": "n",
+                        "in": "path",
+                        "description": "Number of bytes",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "seed",
+                        "in": "query",
+                        "description": "Seed of the random generator",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/octet-stream": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cache": {
+            "get": {
+                "tags": [
+                    "Response inspection"
+                ],
+                "summary": "Returns a 304 if an If-Modified-Since header or If-None-Match is present. Returns the same as a GET otherwise.",
+                "description": "Returns 304 when If-Modified-Since or If-None-Match is sent, otherwise the /get response with Last-Modified and ETag.",
+                "parameters": [
+                    {
+                        "name": "If-Modified-Since",
+                        "in": "header",
+                        "description": "Date",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "If-None-Match",
+                        "in": "header",
+                        "description": "Entity tags",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    }
+                }
+            }
+        },
+        "/cache/{value}": {
+            "get": {
+                "tags": [
+                    "Response inspection"
+                ],
+                "summary": "Sets a Cache-Control header for n seconds.",
+                "description": "Returns the /get response with Cache-Control: public, max-age=n.",
+                "parameters": [
+                    {
+                        "name": "value",
+                        "in": "path",
+                        "description": "max-age in seconds",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/client-cert": {
+            "get": {
+                "tags": [
+                    "Request inspection"
+                ],
+                "summary": "Return the certificate chain presented by the client.",
+                "description": "It returns the TLS client certificates (subject, issuer, SANs, serial, validity, fingerprints and key type) and whether they were verified against TLS_CLIENT_CA_FILE. The server asks for them as per TLS_CLIENT_AUTH.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ClientCertResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/compressed": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns data compressed with the coding chosen by the Accept-Encoding header.",
+                "description": "Picks br, zstd, gzip, deflate or identity following the Accept-Encoding q-values. The encoding query applies a list of codings in the given order, e.g. gzip,br is sent as Content-Encoding: gzip, br.",
+                "parameters": [
+                    {
+                        "name": "Accept-Encoding",
+                        "in": "header",
+                        "description": "Accepted content codings",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "encoding",
+                        "in": "query",
+                        "description": "Comma separated codings to apply in order, overrides Accept-Encoding",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "level",
+                        "in": "query",
+                        "description": "Compression level, 0 selects the default of each coding",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.CompressedResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cookies": {
+            "get": {
+                "tags": [
+                    "Cookies"
+                ],
+                "summary": "Returns cookie data.",
+                "description": "Returns the cookies sent with the request.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.CookiesResponse"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cookies/delete": {
+            "get": {
+                "tags": [
+                    "Cookies"
+                ],
+                "summary": "Deletes the cookies given in the query and redirects to /cookies.",
+                "description": "Expires every cookie named in the query, e.g. /cookies/delete?name.",
+                "parameters": [
+                    {
+                        "name": "freeform",
+                        "in": "query",
+                        "description": "Cookie name",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/cookies/set": {
+            "get": {
+                "tags": [
+                    "Cookies"
+                ],
+                "summary": "Sets the cookies given in the query and redirects to /cookies.",
+                "description": "Sets a cookie for every query parameter, e.g. /cookies/set?name=value.",
+                "parameters": [
+                    {
+                        "name": "freeform",
+                        "in": "query",
+                        "description": "Cookie name and value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/cookies/set-with-attributes": {
+            "get": {
+                "tags": [
+                    "Cookies"
+                ],
+                "summary": "Sets a cookie with the chosen attributes.",
+                "description": "Sets a single cookie with the given Secure, HttpOnly, SameSite, Max-Age, Domain, Path and Partitioned attributes.",
+                "parameters": [
+                    {
+                        "name": "name",
+                        "in": "query",
+                        "description": "Cookie name",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "value",
+                        "in": "query",
+                        "description": "Cookie value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "secure",
+                        "in": "query",
+                        "description": "Secure",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    },
+                    {
+                        "name": "httponly",
+                        "in": "query",
+                        "description": "HttpOnly",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    },
+                    {
+                        "name": "samesite",
+                        "in": "query",
+                        "description": "SameSite: lax, strict or none",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "max_age",
+                        "in": "query",
+                        "description": "Max-Age in seconds",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "domain",
+                        "in": "query",
+                        "description": "Domain",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "path",
+                        "in": "query",
+                        "description": "Path",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "partitioned",
+                        "in": "query",
+                        "description": "Partitioned",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.SetCookieResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/deflate": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns Deflate-encoded data.",
+                "description": "Returns Deflate-encoded data.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.DeflateResponse"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/delay/{seconds}": {
+            "get": {
+                "tags": [
+                    "Dynamic data"
+                ],
+                "summary": "Returns a delayed response.",
+                "description": "Waits for the given number of seconds (capped by the server) before returning the request data.",
+                "parameters": [
+                    {
+                        "name": "seconds",
+                        "in": "path",
+                        "description": "Delay in seconds",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "jitter",
+                        "in": "query",
+                        "description": "Jitter in seconds",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "distribution",
+                        "in": "query",
+                        "description": "Jitter distribution: uniform, normal or exponential",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "tags": [
+                    "Dynamic data"
+                ],
+                "summary": "Returns a delayed response.",
+                "description": "Waits for the given number of seconds (capped by the server) before returning the request data.",
+                "parameters": [
+                    {
+                        "name": "seconds",
+                        "in": "path",
+                        "description": "Delay in seconds",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "jitter",
+                        "in": "query",
+                        "description": "Jitter in seconds",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "distribution",
+                        "in": "query",
+                        "description": "Jitter distribution: uniform, normal or exponential",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "tags": [
+                    "Dynamic data"
+                ],
+                "summary": "Returns a delayed response.",
+                "description": "Waits for the given number of seconds (capped by the server) before returning the request data.",
+                "parameters": [
+                    {
+                        "name": "seconds",
+                        "in": "path",
+                        "description": "Delay in seconds",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "jitter",
+                        "in": "query",
+                        "description": "Jitter in seconds",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "distribution",
+                        "in": "query",
+                        "description": "Jitter distribution: uniform, normal or exponential",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "tags": [
+                    "Dynamic data"
+                ],
+                "summary": "Returns a delayed response.",
+                "description": "Waits for the given number of seconds (capped by the server) before returning the request data.",
+                "parameters": [
+                    {
+                        "name": "seconds",
+                        "in": "path",
+                        "description": "Delay in seconds",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "jitter",
+                        "in": "query",
+                        "description": "Jitter in seconds",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "distribution",
+                        "in": "query",
+                        "description": "Jitter distribution: uniform, normal or exponential",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "Dynamic data"
+                ],
+                "summary": "Returns a delayed response.",
+                "description": "Waits for the given number of seconds (capped by the server) before returning the request data.",
+                "parameters": [
+                    {
+                        "name": "seconds",
+                        "in": "path",
+                        "description": "Delay in seconds",
+                        "required": true,
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "jitter",
+                        "in": "query",
+                        "description": "Jitter in seconds",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "distribution",
+                        "in": "query",
+                        "description": "Jitter distribution: uniform, normal or exponential",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/deny": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns page denied by robots.txt rules.",
+                "description": "Returns page denied by robots.txt rules.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/digest-auth/{qop}/{user}/{passwd}": {
+            "get": {
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Prompts the user for authorization using HTTP Digest Auth.",
+                "description": "Challenges the client with the given qop and algorithm (MD5 by default), a stale nonce is answered with stale=true.",
+                "parameters": [
+                    {
+                        "name": "qop",
+                        "in": "path",
+                        "description": "auth or auth-int",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "user",
+                        "in": "path",
+                        "description": "User",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "passwd",
+                        "in": "path",
+                        "description": "Password",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.AuthResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/digest-auth/{qop}/{user}/{passwd}/{algorithm}": {
+            "get": {
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Prompts the user for authorization using HTTP Digest Auth.",
+                "description": "Challenges the client with the given qop and algorithm (MD5 by default), a stale nonce is answered with stale=true.",
+                "parameters": [
+                    {
+                        "name": "qop",
+                        "in": "path",
+                        "description": "auth or auth-int",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "user",
+                        "in": "path",
+                        "description": "User",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "passwd",
+                        "in": "path",
+                        "description": "Password",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "algorithm",
+                        "in": "path",
+                        "description": "MD5, SHA-256, MD5-sess or SHA-256-sess",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.AuthResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/drip": {
+            "get": {
+                "tags": [
+                    "Dynamic data"
+                ],
+                "summary": "Drips data over a duration after an optional initial delay.",
+                "description": "Sends numbytes bytes spread evenly over duration seconds, after waiting delay seconds, with the given status code.",
+                "parameters": [
+                    {
+                        "name": "duration",
+                        "in": "query",
+                        "description": "Duration in seconds over which the data is sent",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "numbytes",
+                        "in": "query",
+                        "description": "Number of bytes to send",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "delay",
+                        "in": "query",
+                        "description": "Initial delay in seconds",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "code",
+                        "in": "query",
+                        "description": "Status code of the response",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/octet-stream": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/etag/{etag}": {
+            "get": {
+                "tags": [
+                    "Response inspection"
+                ],
+                "summary": "Assumes the resource has the given etag and responds to If-None-Match and If-Match headers appropriately.",
+                "description": "If-None-Match is compared weakly and answers 304, If-Match is compared strongly and answers 412. The etag is weak when weak=true.",
+                "parameters": [
+                    {
+                        "name": "etag",
+                        "in": "path",
+                        "description": "Entity tag of the resource",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "weak",
+                        "in": "query",
+                        "description": "Use a weak entity tag",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    },
+                    {
+                        "name": "If-None-Match",
+                        "in": "header",
+                        "description": "Entity tags",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "If-Match",
+                        "in": "header",
+                        "description": "Entity tags",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    }
+                }
+            }
+        },
+        "/get": {
+            "get": {
+                "tags": [
+                    "HTTP Methods"
+                ],
+                "summary": "Returns the request parameters.",
+                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/gzip": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns GZip-encoded data.",
+                "description": "Returns GZip-encoded data.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.GzipResponse"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/head": {
+            "head": {
+                "tags": [
+                    "HTTP Methods"
+                ],
+                "summary": "Returns the request parameters.",
+                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/headers": {
+            "get": {
+                "tags": [
+                    "Request inspection"
+                ],
+                "summary": "Return the incoming request's HTTP headers.",
+                "description": "It returns the incoming request's HTTP headers.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HeaderResponse"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/hidden-basic-auth/{user}/{passwd}": {
+            "get": {
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Prompts the user for authorization using HTTP Basic Auth.",
+                "description": "Same as /basic-auth but answers 404 instead of challenging the client.",
+                "parameters": [
+                    {
+                        "name": "user",
+                        "in": "path",
+                        "description": "User",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "passwd",
+                        "in": "path",
+                        "description": "Password",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.AuthResponse"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/html": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns a simple HTML document.",
+                "description": "Returns a simple HTML document.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/apng": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/avif": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/bmp": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/gif": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/ico": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/jpeg": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/png": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/svg": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/tiff": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/image/webp": {
+            "get": {
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Returns image as per the Accept header.",
+                "description": "Returns a simple image of the type suggest by the Accept header, q-values and wildcards are honored.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "image/*": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/apng": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/avif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/bmp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/gif": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/jpeg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/png": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/svg": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/tiff": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/webp": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "image/x-icon": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/inspect/stream": {
+            "get": {
+                "tags": [
+                    "Request inspection"
+                ],
+                "summary": "Streams the inspected requests as Server-Sent Events.",
+                "description": "Sends a \"request\" event for every request hitting the watched bin or path.",
+                "parameters": [
+                    {
+                        "name": "bin",
+                        "in": "query",
+                        "description": "Bin ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "path",
+                        "in": "query",
+                        "description": "Path prefix to watch, required without bin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.InspectEventResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "content": {
+                            "text/event-stream": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/inspect/ws": {
+            "get": {
+                "tags": [
+                    "Request inspection"
+                ],
+                "summary": "Streams the inspected requests over a WebSocket.",
+                "description": "Sends a JSON message for every request hitting the watched bin or path.",
+                "parameters": [
+                    {
+                        "name": "bin",
+                        "in": "query",
+                        "description": "Bin ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "path",
+                        "in": "query",
+                        "description": "Path prefix to watch, required without bin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.InspectEventResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/ip": {
+            "get": {
+                "tags": [
+                    "Request inspection"
+                ],
+                "summary": "Get Request IP.",
+                "description": "Returns the requester's IP Address.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.IPResponse"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/json": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns a simple JSON document.",
+                "description": "Returns a simple JSON document.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/negotiate": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns the request data in the format chosen by the Accept header.",
+                "description": "Returns the /get response as JSON, XML, HTML, YAML, MessagePack, CBOR or plain text following the Accept header q-values and wildcards. Answers 406 with the available types when none is acceptable.",
+                "parameters": [
+                    {
+                        "name": "Accept",
+                        "in": "header",
+                        "description": "Accepted content types",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/cbor": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            },
+                            "application/msgpack": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            },
+                            "application/xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            },
+                            "application/yaml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            },
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.EmptyResponse"
+                                }
+                            }
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "content": {
+                            "application/cbor": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "application/msgpack": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "application/xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "application/yaml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.NotAcceptableError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/cbor": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "application/msgpack": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "application/xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "application/yaml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "text/html": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            },
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/options": {
+            "options": {
+                "tags": [
+                    "HTTP Methods"
+                ],
+                "summary": "Returns the request parameters.",
+                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/patch": {
+            "patch": {
+                "tags": [
+                    "HTTP Methods"
+                ],
+                "summary": "Returns the request parameters.",
+                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc. Bodies sent with Content-Encoding gzip, deflate, br or zstd are decompressed first.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/post": {
+            "post": {
+                "tags": [
+                    "HTTP Methods"
+                ],
+                "summary": "Returns the request parameters.",
+                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc. Bodies sent with Content-Encoding gzip, deflate, br or zstd are decompressed first.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
-                            "image/avif": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
@@ -479,29 +4563,103 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error"
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     }
                 }
             }
         },
-        "/image/bmp": {
-            "get": {
+        "/put": {
+            "put": {
                 "tags": [
-                    "Images"
+                    "HTTP Methods"
+                ],
+                "summary": "Returns the request parameters.",
+                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc. Bodies sent with Content-Encoding gzip, deflate, br or zstd are decompressed first.",
+                "parameters": [
+                    {
+                        "name": "customheader",
+                        "in": "header",
+                        "description": "Header",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "queryparam",
+                        "in": "query",
+                        "description": "Query Paramater",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 ],
-                "summary": "Returns a simple BMP image.",
-                "description": "Returns a simple image of the BMP mime-type.",
+                "requestBody": {
+                    "content": {
+                        "*/*": {
+                            "schema": {
+                                "type": "object",
+                                "properties": {
+                                    "body": {
+                                        "type": "string",
+                                        "description": "Body",
+                                        "x-formData-name": "body"
+                                    },
+                                    "formdata": {
+                                        "type": "string",
+                                        "description": "Form Data",
+                                        "format": "binary",
+                                        "x-formData-name": "formdata"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
-                            "image/bmp": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
@@ -509,239 +4667,416 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error"
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     }
                 }
             }
         },
-        "/image/gif": {
+        "/range/{n}": {
             "get": {
                 "tags": [
-                    "Images"
+                    "Dynamic data"
+                ],
+                "summary": "Streams n bytes, and allows specifying a Range header to select a subset of the data.",
+                "description": "Honors Range (including multiple ranges), If-Range and conditional headers, answers 416 for unsatisfiable ranges.",
+                "parameters": [
+                    {
+                        "name": "n",
+                        "in": "path",
+                        "description": "Number of bytes",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "Range",
+                        "in": "header",
+                        "description": "Byte ranges, e.g. bytes=0-9,20-",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "206": {
+                        "description": "Partial Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/octet-stream": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "416": {
+                        "description": "Requested Range Not Satisfiable"
+                    }
+                }
+            }
+        },
+        "/redirect-to": {
+            "get": {
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Redirects to the given URL.",
+                "description": "Redirects to the given URL with any 3xx status code (302 by default). The server may restrict the hosts it redirects to.",
+                "parameters": [
+                    {
+                        "name": "url",
+                        "in": "query",
+                        "description": "Redirect target",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "status_code",
+                        "in": "query",
+                        "description": "3xx status code",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Redirects to the given URL.",
+                "description": "Redirects to the given URL with any 3xx status code (302 by default). The server may restrict the hosts it redirects to.",
+                "parameters": [
+                    {
+                        "name": "url",
+                        "in": "query",
+                        "description": "Redirect target",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "status_code",
+                        "in": "query",
+                        "description": "3xx status code",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Redirects to the given URL.",
+                "description": "Redirects to the given URL with any 3xx status code (302 by default). The server may restrict the hosts it redirects to.",
+                "parameters": [
+                    {
+                        "name": "url",
+                        "in": "query",
+                        "description": "Redirect target",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "status_code",
+                        "in": "query",
+                        "description": "3xx status code",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
                 ],
-                "summary": "Returns a simple GIF image.",
-                "description": "Returns a simple image of the GIF mime-type.",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "content": {
-                            "image/gif": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                }
-            }
-        },
-        "/image/ico": {
-            "get": {
-                "tags": [
-                    "Images"
-                ],
-                "summary": "Returns a simple ICO image.",
-                "description": "Returns a simple image of the ICO mime-type.",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "403": {
+                        "description": "Forbidden",
                         "content": {
-                            "image/x-icon": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
                     }
                 }
-            }
-        },
-        "/image/jpeg": {
-            "get": {
+            },
+            "patch": {
                 "tags": [
-                    "Images"
+                    "Redirects"
+                ],
+                "summary": "Redirects to the given URL.",
+                "description": "Redirects to the given URL with any 3xx status code (302 by default). The server may restrict the hosts it redirects to.",
+                "parameters": [
+                    {
+                        "name": "url",
+                        "in": "query",
+                        "description": "Redirect target",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "status_code",
+                        "in": "query",
+                        "description": "3xx status code",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
                 ],
-                "summary": "Returns a simple JPEG image.",
-                "description": "Returns a simple image of the JPEG mime-type.",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "content": {
-                            "image/jpeg": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                }
-            }
-        },
-        "/image/png": {
-            "get": {
-                "tags": [
-                    "Images"
-                ],
-                "summary": "Returns a simple PNG image.",
-                "description": "Returns a simple image of the PNG mime-type.",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "403": {
+                        "description": "Forbidden",
                         "content": {
-                            "image/png": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
                     }
                 }
-            }
-        },
-        "/image/svg": {
-            "get": {
+            },
+            "delete": {
                 "tags": [
-                    "Images"
+                    "Redirects"
+                ],
+                "summary": "Redirects to the given URL.",
+                "description": "Redirects to the given URL with any 3xx status code (302 by default). The server may restrict the hosts it redirects to.",
+                "parameters": [
+                    {
+                        "name": "url",
+                        "in": "query",
+                        "description": "Redirect target",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "status_code",
+                        "in": "query",
+                        "description": "3xx status code",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
                 ],
-                "summary": "Returns a simple SVG image.",
-                "description": "Returns a simple image of the SVG mime-type.",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "content": {
-                            "image/svg+xml": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
-                    }
-                }
-            }
-        },
-        "/image/tiff": {
-            "get": {
-                "tags": [
-                    "Images"
-                ],
-                "summary": "Returns a simple TIFF image.",
-                "description": "Returns a simple image of the TIFF mime-type.",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "403": {
+                        "description": "Forbidden",
                         "content": {
-                            "image/tiff": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/image/webp": {
+        "/redirect/{n}": {
             "get": {
                 "tags": [
-                    "Images"
+                    "Redirects"
+                ],
+                "summary": "302 Redirects n times.",
+                "description": "Redirects n times before landing on /get, absolute redirects are used when absolute=true.",
+                "parameters": [
+                    {
+                        "name": "n",
+                        "in": "path",
+                        "description": "Number of redirects",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "absolute",
+                        "in": "query",
+                        "description": "Use absolute redirects",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    }
                 ],
-                "summary": "Returns a simple WEBP image.",
-                "description": "Returns a simple image of the WEBP mime-type.",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "content": {
-                            "image/webp": {
+                            "application/json": {
                                 "schema": {
-                                    "format": "binary"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
-                    "500": {
-                        "description": "Internal Server Error"
                     }
                 }
             }
         },
-        "/ip": {
+        "/relative-redirect/{n}": {
             "get": {
                 "tags": [
-                    "Request inspection"
+                    "Redirects"
+                ],
+                "summary": "Relatively 302 Redirects n times.",
+                "description": "Redirects n times using relative Location headers before landing on /get.",
+                "parameters": [
+                    {
+                        "name": "n",
+                        "in": "path",
+                        "description": "Number of redirects",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
                 ],
-                "summary": "Get Request IP.",
-                "description": "Returns the requester's IP Address.",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "content": {
                             "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.IPResponse"
+                                    "$ref": "#/components/schemas/response.HTTPError"
                                 }
                             }
                         }
@@ -749,49 +5084,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/json": {
+        "/response-headers": {
             "get": {
                 "tags": [
-                    "Response formats"
+                    "Response inspection"
+                ],
+                "summary": "Returns a set of response headers from the query string.",
+                "description": "Sets every query parameter as a response header and returns them as JSON.",
+                "parameters": [
+                    {
+                        "name": "freeform",
+                        "in": "query",
+                        "description": "Header name and value",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 ],
-                "summary": "Returns a simple JSON document.",
-                "description": "Returns a simple JSON document.",
                 "responses": {
                     "200": {
                         "description": "OK"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "406": {
-                        "description": "Not Acceptable",
-                        "content": {}
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "content": {
-                            "text/plain": {
-                                "schema": {
-                                    "$ref": "#/components/schemas/response.HTTPError"
-                                }
-                            }
-                        }
                     }
                 }
-            }
-        },
-        "/options": {
-            "options": {
+            },
+            "post": {
                 "tags": [
-                    "HTTP Methods"
+                    "Response inspection"
                 ],
-                "summary": "Returns the request parameters.",
-                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "summary": "Returns a set of response headers from the query string.",
+                "description": "Sets every query parameter as a response header and returns them as JSON.",
                 "parameters": [
                     {
-                        "name": "customheader",
-                        "in": "header",
-                        "description": "Header",
+                        "name": "freeform",
+                        "in": "query",
+                        "description": "Header name and value",
                         "schema": {
                             "type": "string"
                         }
@@ -799,21 +5125,32 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "content": {}
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "content": {}
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/robots.txt": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns some robots.txt rules.",
+                "description": "Returns some robots.txt rules.",
+                "responses": {
+                    "200": {
+                        "description": "OK"
                     },
                     "404": {
-                        "description": "Not Found",
-                        "content": {}
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "content": {
-                            "*/*": {
+                            "text/plain": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.HTTPError"
                                 }
@@ -823,74 +5160,89 @@ const docTemplate = `{
                 }
             }
         },
-        "/patch": {
-            "patch": {
+        "/sse": {
+            "get": {
                 "tags": [
-                    "HTTP Methods"
+                    "Dynamic data"
                 ],
-                "summary": "Returns the request parameters.",
-                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "summary": "Streams Server-Sent Events.",
+                "description": "Sends count events numbered from 1 with id, event and retry fields. A Last-Event-ID header resumes after the given event, 204 tells the client every event was delivered. drop_after cuts the connection to exercise the reconnection.",
                 "parameters": [
                     {
-                        "name": "customheader",
-                        "in": "header",
-                        "description": "Header",
+                        "name": "count",
+                        "in": "query",
+                        "description": "Number of events (default 10)",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "interval",
+                        "in": "query",
+                        "description": "Seconds between the events (default 1)",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "event",
+                        "in": "query",
+                        "description": "Event type, message when empty",
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "name": "queryparam",
+                        "name": "retry",
                         "in": "query",
-                        "description": "Query Paramater",
+                        "description": "Reconnection time in milliseconds sent to the client",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "drop_after",
+                        "in": "query",
+                        "description": "Drop the connection after sending this many events",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "payload",
+                        "in": "query",
+                        "description": "counter or request, the latter sends the /get response",
                         "schema": {
                             "type": "string"
                         }
-                    }
-                ],
-                "requestBody": {
-                    "content": {
-                        "multipart/form-data": {
-                            "schema": {
-                                "type": "object",
-                                "properties": {
-                                    "body": {
-                                        "type": "string",
-                                        "description": "Body"
-                                    },
-                                    "formdata": {
-                                        "type": "string",
-                                        "description": "Form Data",
-                                        "format": "binary"
-                                    }
-                                }
-                            }
+                    },
+                    {
+                        "name": "Last-Event-ID",
+                        "in": "header",
+                        "description": "ID of the last event received",
+                        "schema": {
+                            "type": "string"
                         }
                     }
-                },
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
-                            "*/*": {
+                            "text/event-stream": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                    "$ref": "#/components/schemas/response.SSEEventResponse"
                                 }
                             }
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
-                        "content": {}
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "content": {}
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
                         "content": {
-                            "*/*": {
+                            "text/event-stream": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.HTTPError"
                                 }
@@ -900,74 +5252,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/post": {
-            "post": {
+        "/status/{statuscode}": {
+            "get": {
                 "tags": [
-                    "HTTP Methods"
+                    "Status Codes"
                 ],
-                "summary": "Returns the request parameters.",
-                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "summary": "Return status code or random status code if more than one are given.",
+                "description": "Returns the given status code, or picks one at random from a list which may be weighted (e.g. 200:0.8,500:0.2). The headers required by the code (Location, WWW-Authenticate, Retry-After etc.) are set as well.",
                 "parameters": [
                     {
-                        "name": "customheader",
-                        "in": "header",
-                        "description": "Header",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "name": "queryparam",
-                        "in": "query",
-                        "description": "Query Paramater",
+                        "name": "statuscode",
+                        "in": "path",
+                        "description": "Status Code",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     }
                 ],
-                "requestBody": {
-                    "content": {
-                        "multipart/form-data": {
-                            "schema": {
-                                "type": "object",
-                                "properties": {
-                                    "body": {
-                                        "type": "string",
-                                        "description": "Body"
-                                    },
-                                    "formdata": {
-                                        "type": "string",
-                                        "description": "Form Data",
-                                        "format": "binary"
-                                    }
-                                }
-                            }
-                        }
-                    }
-                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
-                            "*/*": {
+                            "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                    "$ref": "#/components/schemas/response.Response"
                                 }
                             }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
-                        "content": {}
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     },
                     "404": {
-                        "description": "Not Found",
-                        "content": {}
+                        "description": "Not Found"
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "content": {
-                            "*/*": {
+                            "application/json": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.HTTPError"
                                 }
@@ -975,74 +5305,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/put": {
-            "put": {
+            },
+            "post": {
                 "tags": [
-                    "HTTP Methods"
+                    "Status Codes"
                 ],
-                "summary": "Returns the request parameters.",
-                "description": "Returns different type of request parameters like form data, json data, raw data, headers etc.",
+                "summary": "Return status code or random status code if more than one are given.",
+                "description": "Returns the given status code, or picks one at random from a list which may be weighted (e.g. 200:0.8,500:0.2). The headers required by the code (Location, WWW-Authenticate, Retry-After etc.) are set as well.",
                 "parameters": [
                     {
-                        "name": "customheader",
-                        "in": "header",
-                        "description": "Header",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "name": "queryparam",
-                        "in": "query",
-                        "description": "Query Paramater",
+                        "name": "statuscode",
+                        "in": "path",
+                        "description": "Status Code",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     }
                 ],
-                "requestBody": {
-                    "content": {
-                        "multipart/form-data": {
-                            "schema": {
-                                "type": "object",
-                                "properties": {
-                                    "body": {
-                                        "type": "string",
-                                        "description": "Body"
-                                    },
-                                    "formdata": {
-                                        "type": "string",
-                                        "description": "Form Data",
-                                        "format": "binary"
-                                    }
-                                }
-                            }
-                        }
-                    }
-                },
                 "responses": {
                     "200": {
                         "description": "OK",
                         "content": {
-                            "*/*": {
+                            "application/json": {
                                 "schema": {
-                                    "$ref": "#/components/schemas/response.BodyDataResponse"
+                                    "$ref": "#/components/schemas/response.Response"
                                 }
                             }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
-                        "content": {}
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "content": {}
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
                         "content": {
                             "application/json": {
                                 "schema": {
@@ -1050,27 +5343,10 @@ const docTemplate = `{
                                 }
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/robots.txt": {
-            "get": {
-                "tags": [
-                    "Response formats"
-                ],
-                "summary": "Returns some robots.txt rules.",
-                "description": "Returns some robots.txt rules.",
-                "responses": {
-                    "200": {
-                        "description": "OK"
                     },
                     "404": {
                         "description": "Not Found"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "content": {
@@ -1082,15 +5358,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/status/{statuscode}": {
-            "get": {
+            },
+            "put": {
                 "tags": [
                     "Status Codes"
                 ],
                 "summary": "Return status code or random status code if more than one are given.",
-                "description": "Returns the requester's IP Address.",
+                "description": "Returns the given status code, or picks one at random from a list which may be weighted (e.g. 200:0.8,500:0.2). The headers required by the code (Location, WWW-Authenticate, Retry-After etc.) are set as well.",
                 "parameters": [
                     {
                         "name": "statuscode",
@@ -1098,16 +5372,30 @@ const docTemplate = `{
                         "description": "Status Code",
                         "required": true,
                         "schema": {
-                            "type": "integer"
+                            "type": "string"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.Response"
+                                }
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Bad Request"
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     },
                     "404": {
                         "description": "Not Found"
@@ -1124,12 +5412,12 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "patch": {
                 "tags": [
                     "Status Codes"
                 ],
                 "summary": "Return status code or random status code if more than one are given.",
-                "description": "Returns the requester's IP Address.",
+                "description": "Returns the given status code, or picks one at random from a list which may be weighted (e.g. 200:0.8,500:0.2). The headers required by the code (Location, WWW-Authenticate, Retry-After etc.) are set as well.",
                 "parameters": [
                     {
                         "name": "statuscode",
@@ -1137,16 +5425,30 @@ const docTemplate = `{
                         "description": "Status Code",
                         "required": true,
                         "schema": {
-                            "type": "integer"
+                            "type": "string"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.Response"
+                                }
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Bad Request"
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     },
                     "404": {
                         "description": "Not Found"
@@ -1163,12 +5465,12 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "tags": [
                     "Status Codes"
                 ],
                 "summary": "Return status code or random status code if more than one are given.",
-                "description": "Returns the requester's IP Address.",
+                "description": "Returns the given status code, or picks one at random from a list which may be weighted (e.g. 200:0.8,500:0.2). The headers required by the code (Location, WWW-Authenticate, Retry-After etc.) are set as well.",
                 "parameters": [
                     {
                         "name": "statuscode",
@@ -1176,16 +5478,30 @@ const docTemplate = `{
                         "description": "Status Code",
                         "required": true,
                         "schema": {
-                            "type": "integer"
+                            "type": "string"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.Response"
+                                }
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Bad Request"
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
                     },
                     "404": {
                         "description": "Not Found"
@@ -1201,22 +5517,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/stream-bytes/{n}": {
+            "get": {
                 "tags": [
-                    "Status Codes"
+                    "Dynamic data"
                 ],
-                "summary": "Return status code or random status code if more than one are given.",
-                "description": "Returns the requester's IP Address.",
+                "summary": "Streams n random bytes generated with the given seed in chunks.",
+                "description": "Streams n random bytes in chunk_size chunks, the same seed always generates the same bytes.",
                 "parameters": [
                     {
-                        "name": "statuscode",
+                        "name": "n",
                         "in": "path",
-                        "description": "Status Code",
+                        "description": "Number of bytes",
                         "required": true,
                         "schema": {
                             "type": "integer"
                         }
+                    },
+                    {
+                        "name": "chunk_size",
+                        "in": "query",
+                        "description": "Size of the chunks",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "seed",
+                        "in": "query",
+                        "description": "Seed of the random generator",
+                        "schema": {
+                            "type": "integer"
+                        }
                     }
                 ],
                 "responses": {
@@ -1224,15 +5558,9 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "Bad Request"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                        "description": "Bad Request",
                         "content": {
-                            "application/json": {
+                            "application/octet-stream": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.HTTPError"
                                 }
@@ -1240,18 +5568,20 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/stream/{n}": {
+            "get": {
                 "tags": [
-                    "Status Codes"
+                    "Dynamic data"
                 ],
-                "summary": "Return status code or random status code if more than one are given.",
-                "description": "Returns the requester's IP Address.",
+                "summary": "Streams n JSON responses.",
+                "description": "Streams n newline delimited copies of the /get response (at most 100).",
                 "parameters": [
                     {
-                        "name": "statuscode",
+                        "name": "n",
                         "in": "path",
-                        "description": "Status Code",
+                        "description": "Number of lines",
                         "required": true,
                         "schema": {
                             "type": "integer"
@@ -1260,18 +5590,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "content": {
+                            "application/x-ndjson": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.StreamResponse"
+                                }
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Bad Request"
-                    },
-                    "404": {
-                        "description": "Not Found"
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                        "description": "Bad Request",
                         "content": {
-                            "application/json": {
+                            "application/x-ndjson": {
                                 "schema": {
                                     "$ref": "#/components/schemas/response.HTTPError"
                                 }
@@ -1302,6 +5633,96 @@ const docTemplate = `{
                 }
             }
         },
+        "/ws/echo": {
+            "get": {
+                "tags": [
+                    "WebSocket"
+                ],
+                "summary": "Echoes the text and binary messages sent over a WebSocket.",
+                "description": "Echoes every message with its type. The query options send pings, close the connection after some messages, delay the replies, split them into frames and negotiate subprotocols and permessage-deflate.",
+                "parameters": [
+                    {
+                        "name": "ping_interval",
+                        "in": "query",
+                        "description": "Seconds between the pings sent by the server",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "close_after",
+                        "in": "query",
+                        "description": "Close the connection after echoing this many messages",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "close_code",
+                        "in": "query",
+                        "description": "Close code sent with close_after (default 1000)",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "close_reason",
+                        "in": "query",
+                        "description": "Close reason sent with close_after",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "delay",
+                        "in": "query",
+                        "description": "Seconds to wait before each reply",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "name": "fragment",
+                        "in": "query",
+                        "description": "Largest payload in bytes of each frame",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "name": "subprotocols",
+                        "in": "query",
+                        "description": "Comma separated subprotocols the server accepts, by default the first one offered is accepted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "name": "compression",
+                        "in": "query",
+                        "description": "Negotiate permessage-deflate",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "content": {
+                            "application/json": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/xml": {
             "get": {
                 "tags": [
@@ -1313,67 +5734,185 @@ const docTemplate = `{
                     "200": {
                         "description": "OK"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "content": {}
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "application/xml": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/zstd": {
+            "get": {
+                "tags": [
+                    "Response formats"
+                ],
+                "summary": "Returns Zstd-compressed data.",
+                "description": "Returns Zstd-compressed data.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.ZstdResponse"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "406": {
+                        "description": "Not Acceptable"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "content": {
+                            "text/plain": {
+                                "schema": {
+                                    "$ref": "#/components/schemas/response.HTTPError"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "components": {
+        "schemas": {
+            "response.AuthResponse": {
+                "type": "object",
+                "properties": {
+                    "authenticated": {
+                        "type": "boolean",
+                        "example": true
+                    },
+                    "user": {
+                        "type": "string",
+                        "example": "user"
+                    }
+                }
+            },
+            "response.BearerResponse": {
+                "type": "object",
+                "properties": {
+                    "authenticated": {
+                        "type": "boolean",
+                        "example": true
+                    },
+                    "token": {
+                        "type": "string",
+                        "example": "token"
+                    }
+                }
+            },
+            "response.BinRequestResponse": {
+                "type": "object",
+                "properties": {
+                    "arg": {
+                        "type": "object",
+                        "additionalProperties": true
+                    },
+                    "body_size": {
+                        "type": "integer"
+                    },
+                    "cipher_suite": {
+                        "type": "string",
+                        "example": "TLS_AES_128_GCM_SHA256"
+                    },
+                    "data": {},
+                    "decoded_body_size": {
+                        "type": "integer"
+                    },
+                    "files": {},
+                    "form": {},
+                    "headers": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "string"
+                        }
+                    },
+                    "id": {
+                        "type": "string"
+                    },
+                    "ip": {
+                        "type": "array",
+                        "items": {}
+                    },
+                    "json": {},
+                    "method": {
+                        "type": "string"
+                    },
+                    "protocol": {
+                        "type": "string",
+                        "example": "HTTP/2.0"
+                    },
+                    "timestamp": {
+                        "type": "string"
+                    },
+                    "tls_version": {
+                        "type": "string",
+                        "example": "TLS 1.3"
+                    },
+                    "url": {
+                        "type": "string"
+                    }
+                }
+            },
+            "response.BinRequestsResponse": {
+                "type": "object",
+                "properties": {
+                    "bin_id": {
+                        "type": "string"
+                    },
+                    "limit": {
+                        "type": "integer"
                     },
-                    "406": {
-                        "description": "Not Acceptable",
-                        "content": {}
+                    "page": {
+                        "type": "integer"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "content": {
-                            "application/json": {
-                                "schema": {
-                                    "$ref": "#/components/schemas/response.HTTPError"
-                                }
-                            }
+                    "requests": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/components/schemas/response.BinRequestResponse"
                         }
+                    },
+                    "total": {
+                        "type": "integer"
                     }
                 }
-            }
-        },
-        "/zstd": {
-            "get": {
-                "tags": [
-                    "Response formats"
-                ],
-                "summary": "Returns Zstd-compressed data.",
-                "description": "Returns Zstd-compressed data.",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "content": {
-                            "application/json": {
-                                "schema": {
-                                    "$ref": "#/components/schemas/response.ZstdResponse"
-                                }
-                            }
-                        }
+            },
+            "response.BinResponse": {
+                "type": "object",
+                "properties": {
+                    "created_at": {
+                        "type": "string"
                     },
-                    "404": {
-                        "description": "Not Found"
+                    "id": {
+                        "type": "string",
+                        "example": "5f2b9c1d7e4a3b60"
                     },
-                    "406": {
-                        "description": "Not Acceptable"
+                    "requests_url": {
+                        "type": "string"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "content": {
-                            "application/json": {
-                                "schema": {
-                                    "$ref": "#/components/schemas/response.HTTPError"
-                                }
-                            }
-                        }
+                    "url": {
+                        "type": "string"
                     }
                 }
-            }
-        }
-    },
-    "components": {
-        "schemas": {
+            },
             "response.BodyDataResponse": {
                 "type": "object",
                 "properties": {
@@ -1381,15 +5920,19 @@ const docTemplate = `{
                         "type": "object",
                         "additionalProperties": true
                     },
-                    "data": {
-                        "type": "object"
+                    "body_size": {
+                        "type": "integer"
                     },
-                    "files": {
-                        "type": "object"
+                    "cipher_suite": {
+                        "type": "string",
+                        "example": "TLS_AES_128_GCM_SHA256"
                     },
-                    "form": {
-                        "type": "object"
+                    "data": {},
+                    "decoded_body_size": {
+                        "type": "integer"
                     },
+                    "files": {},
+                    "form": {},
                     "headers": {
                         "type": "object",
                         "additionalProperties": {
@@ -1398,16 +5941,20 @@ const docTemplate = `{
                     },
                     "ip": {
                         "type": "array",
-                        "items": {
-                            "type": "object"
-                        }
-                    },
-                    "json": {
-                        "type": "object"
+                        "items": {}
                     },
+                    "json": {},
                     "method": {
                         "type": "string"
                     },
+                    "protocol": {
+                        "type": "string",
+                        "example": "HTTP/2.0"
+                    },
+                    "tls_version": {
+                        "type": "string",
+                        "example": "TLS 1.3"
+                    },
                     "url": {
                         "type": "string"
                     }
@@ -1427,9 +5974,114 @@ const docTemplate = `{
                         }
                     },
                     "ip": {
+                        "type": "array",
+                        "items": {}
+                    }
+                }
+            },
+            "response.CertificateResponse": {
+                "type": "object",
+                "properties": {
+                    "fingerprints": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "string"
+                        }
+                    },
+                    "is_ca": {
+                        "type": "boolean",
+                        "example": false
+                    },
+                    "issuer": {
+                        "type": "string",
+                        "example": "CN=ServeBin CA"
+                    },
+                    "key_type": {
+                        "type": "string",
+                        "example": "ECDSA P-256"
+                    },
+                    "not_after": {
+                        "type": "string",
+                        "example": "2025-01-01T00:00:00Z"
+                    },
+                    "not_before": {
+                        "type": "string",
+                        "example": "2024-01-01T00:00:00Z"
+                    },
+                    "sans": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "example": [
+                            "DNS:client.local"
+                        ]
+                    },
+                    "serial_number": {
+                        "type": "string",
+                        "example": "1f:2a:3b"
+                    },
+                    "signature_algorithm": {
+                        "type": "string",
+                        "example": "ECDSA-SHA256"
+                    },
+                    "subject": {
+                        "type": "string",
+                        "example": "CN=client,O=ServeBin"
+                    }
+                }
+            },
+            "response.ClientCertResponse": {
+                "type": "object",
+                "properties": {
+                    "client_certificates": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/components/schemas/response.CertificateResponse"
+                        }
+                    },
+                    "verified": {
+                        "type": "boolean",
+                        "example": true
+                    }
+                }
+            },
+            "response.CompressedResponse": {
+                "type": "object",
+                "properties": {
+                    "encoding": {
                         "type": "array",
                         "items": {
-                            "type": "object"
+                            "type": "string"
+                        },
+                        "example": [
+                            "gzip",
+                            "br"
+                        ]
+                    },
+                    "headers": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "string"
+                        }
+                    },
+                    "ip": {
+                        "type": "array",
+                        "items": {}
+                    },
+                    "level": {
+                        "type": "integer",
+                        "example": 0
+                    }
+                }
+            },
+            "response.CookiesResponse": {
+                "type": "object",
+                "properties": {
+                    "cookies": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "string"
                         }
                     }
                 }
@@ -1449,9 +6101,7 @@ const docTemplate = `{
                     },
                     "ip": {
                         "type": "array",
-                        "items": {
-                            "type": "object"
-                        }
+                        "items": {}
                     }
                 }
             },
@@ -1462,6 +6112,10 @@ const docTemplate = `{
                         "type": "object",
                         "additionalProperties": true
                     },
+                    "cipher_suite": {
+                        "type": "string",
+                        "example": "TLS_AES_128_GCM_SHA256"
+                    },
                     "headers": {
                         "type": "object",
                         "additionalProperties": {
@@ -1470,13 +6124,19 @@ const docTemplate = `{
                     },
                     "ip": {
                         "type": "array",
-                        "items": {
-                            "type": "object"
-                        }
+                        "items": {}
                     },
                     "method": {
                         "type": "string"
                     },
+                    "protocol": {
+                        "type": "string",
+                        "example": "HTTP/2.0"
+                    },
+                    "tls_version": {
+                        "type": "string",
+                        "example": "TLS 1.3"
+                    },
                     "url": {
                         "type": "string"
                     }
@@ -1497,9 +6157,7 @@ const docTemplate = `{
                     },
                     "ip": {
                         "type": "array",
-                        "items": {
-                            "type": "object"
-                        }
+                        "items": {}
                     }
                 }
             },
@@ -1531,10 +6189,165 @@ const docTemplate = `{
                 "type": "object",
                 "properties": {
                     "ip": {
+                        "type": "array",
+                        "items": {}
+                    }
+                }
+            },
+            "response.InspectEventResponse": {
+                "type": "object",
+                "properties": {
+                    "arg": {
+                        "type": "object",
+                        "additionalProperties": true
+                    },
+                    "bin": {
+                        "type": "string"
+                    },
+                    "body_size": {
+                        "type": "integer"
+                    },
+                    "cipher_suite": {
+                        "type": "string",
+                        "example": "TLS_AES_128_GCM_SHA256"
+                    },
+                    "data": {},
+                    "decoded_body_size": {
+                        "type": "integer"
+                    },
+                    "files": {},
+                    "form": {},
+                    "headers": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "string"
+                        }
+                    },
+                    "ip": {
+                        "type": "array",
+                        "items": {}
+                    },
+                    "json": {},
+                    "method": {
+                        "type": "string"
+                    },
+                    "path": {
+                        "type": "string"
+                    },
+                    "protocol": {
+                        "type": "string",
+                        "example": "HTTP/2.0"
+                    },
+                    "timestamp": {
+                        "type": "string"
+                    },
+                    "tls_version": {
+                        "type": "string",
+                        "example": "TLS 1.3"
+                    },
+                    "truncated": {
+                        "type": "boolean"
+                    },
+                    "url": {
+                        "type": "string"
+                    }
+                }
+            },
+            "response.NotAcceptableError": {
+                "type": "object",
+                "properties": {
+                    "available": {
                         "type": "array",
                         "items": {
-                            "type": "object"
+                            "type": "string"
+                        },
+                        "example": [
+                            "application/json",
+                            "application/xml"
+                        ]
+                    },
+                    "code": {
+                        "type": "integer",
+                        "example": 400
+                    },
+                    "message": {
+                        "type": "string",
+                        "example": "status bad request"
+                    }
+                }
+            },
+            "response.Response": {
+                "type": "object",
+                "properties": {
+                    "code": {
+                        "type": "integer"
+                    },
+                    "data": {},
+                    "status": {
+                        "type": "string"
+                    }
+                }
+            },
+            "response.SSEEventResponse": {
+                "type": "object",
+                "properties": {
+                    "id": {
+                        "type": "integer",
+                        "example": 1
+                    },
+                    "timestamp": {
+                        "type": "string",
+                        "example": "2024-01-01T00:00:00Z"
+                    }
+                }
+            },
+            "response.SetCookieResponse": {
+                "type": "object",
+                "properties": {
+                    "set-cookie": {
+                        "type": "string",
+                        "example": "session=abc; Path=/; HttpOnly; Secure; SameSite=Lax"
+                    }
+                }
+            },
+            "response.StreamResponse": {
+                "type": "object",
+                "properties": {
+                    "arg": {
+                        "type": "object",
+                        "additionalProperties": true
+                    },
+                    "cipher_suite": {
+                        "type": "string",
+                        "example": "TLS_AES_128_GCM_SHA256"
+                    },
+                    "headers": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "string"
                         }
+                    },
+                    "id": {
+                        "type": "integer",
+                        "example": 0
+                    },
+                    "ip": {
+                        "type": "array",
+                        "items": {}
+                    },
+                    "method": {
+                        "type": "string"
+                    },
+                    "protocol": {
+                        "type": "string",
+                        "example": "HTTP/2.0"
+                    },
+                    "tls_version": {
+                        "type": "string",
+                        "example": "TLS 1.3"
+                    },
+                    "url": {
+                        "type": "string"
                     }
                 }
             },
@@ -1561,9 +6374,7 @@ const docTemplate = `{
                     },
                     "ip": {
                         "type": "array",
-                        "items": {
-                            "type": "object"
-                        }
+                        "items": {}
                     }
                 }
             }
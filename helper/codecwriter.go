@@ -0,0 +1,53 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package helper
+
+import (
+	"encoding/json"
+	"github.com/ugorji/go/codec"
+	"gopkg.in/yaml.v3"
+	"io"
+)
+
+// Converts the value into maps, slices and scalars following its JSON tags,
+// so that every format uses the same field names as the JSON responses
+func ToGeneric(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	err = json.Unmarshal(data, &generic)
+	return generic, err
+}
+
+func WriteYAML(w io.Writer, v interface{}) error {
+	generic, err := ToGeneric(v)
+	if err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(w)
+	defer enc.Close()
+	return enc.Encode(generic)
+}
+
+func WriteMsgPack(w io.Writer, v interface{}) error {
+	generic, err := ToGeneric(v)
+	if err != nil {
+		return err
+	}
+	return codec.NewEncoder(w, &codec.MsgpackHandle{}).Encode(generic)
+}
+
+func WriteCBOR(w io.Writer, v interface{}) error {
+	generic, err := ToGeneric(v)
+	if err != nil {
+		return err
+	}
+	return codec.NewEncoder(w, &codec.CborHandle{}).Encode(generic)
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package helper
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnsupportedEncoding = errors.New("unsupported content coding")
+	ErrBodyTooLarge        = errors.New("request body is too large")
+)
+
+// Content codings the server can apply, in the order it prefers them
+var ContentEncodings = []string{"br", "zstd", "gzip", "deflate", "identity"}
+
+// Parses an Accept-Encoding header into the quality of each coding, RFC 9110 section 12.5.3
+func ParseAcceptEncoding(header string) map[string]float64 {
+	qualities := make(map[string]float64)
+
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+
+		coding := strings.ToLower(strings.TrimSpace(fields[0]))
+		if coding == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			key, value, _ := strings.Cut(param, "=")
+			if strings.ToLower(strings.TrimSpace(key)) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				parsed = 0
+			}
+			q = parsed
+		}
+
+		qualities[coding] = q
+	}
+
+	return qualities
+}
+
+// Picks the offered coding the client prefers. Offers are listed in the order
+// the server prefers them, identity is used when Accept-Encoding is missing.
+func NegotiateEncoding(header string, present bool, offers []string) (string, bool) {
+	if !present {
+		return "identity", true
+	}
+
+	qualities := ParseAcceptEncoding(header)
+	wildcard, hasWildcard := qualities["*"]
+
+	best, bestQ := "", 0.0
+	for _, offer := range offers {
+		q, ok := qualities[offer]
+		if !ok {
+			switch {
+			case hasWildcard:
+				q = wildcard
+			case offer == "identity":
+				// identity is acceptable unless excluded explicitly
+				q = 0.001
+			default:
+				q = 0
+			}
+		}
+		if q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+
+	return best, bestQ > 0
+}
+
+// Parses a list of codings like "gzip, br", in the order they are applied
+func ParseContentEncoding(header string) []string {
+	var codings []string
+	for _, coding := range strings.Split(header, ",") {
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "" {
+			codings = append(codings, coding)
+		}
+	}
+	return codings
+}
+
+// Compression levels accepted by each coding, 0 always selects the default level
+var encodingLevels = map[string][2]int{
+	"gzip":    {gzip.BestSpeed, gzip.BestCompression},
+	"deflate": {flate.BestSpeed, flate.BestCompression},
+	"br":      {brotli.BestSpeed, brotli.BestCompression},
+	"zstd":    {1, 22},
+}
+
+// Returns a writer which compresses into w with the given coding and level.
+// The writer must be closed to flush the compressed data.
+func NewEncoder(w io.Writer, encoding string, level int) (io.WriteCloser, error) {
+	if bounds, ok := encodingLevels[encoding]; ok && level != 0 && (level < bounds[0] || level > bounds[1]) {
+		return nil, fmt.Errorf("level of %s must be between %d and %d", encoding, bounds[0], bounds[1])
+	}
+
+	switch encoding {
+	case "gzip":
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		return gzip.NewWriterLevel(w, level)
+	case "deflate":
+		if level == 0 {
+			level = flate.DefaultCompression
+		}
+		return flate.NewWriter(w, level)
+	case "br":
+		if level == 0 {
+			level = brotli.DefaultCompression
+		}
+		return brotli.NewWriterLevel(w, level), nil
+	case "zstd":
+		encoderLevel := zstd.SpeedDefault
+		if level != 0 {
+			encoderLevel = zstd.EncoderLevelFromZstd(level)
+		}
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(encoderLevel))
+	case "identity":
+		return nopWriteCloser{w}, nil
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrUnsupportedEncoding, encoding)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// Returns a reader which decompresses r with the given coding
+func NewDecoder(r io.Reader, encoding string) (io.ReadCloser, error) {
+	switch encoding {
+	case "gzip", "x-gzip":
+		return gzip.NewReader(r)
+	case "deflate":
+		// deflate is meant to be zlib wrapped, but raw streams are common too
+		br := bufio.NewReader(r)
+		header, err := br.Peek(2)
+		if err == nil && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+			return zlib.NewReader(br)
+		}
+		return flate.NewReader(br), nil
+	case "br":
+		return io.NopCloser(brotli.NewReader(r)), nil
+	case "zstd":
+		decoder, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.IOReadCloser(), nil
+	case "identity":
+		return io.NopCloser(r), nil
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrUnsupportedEncoding, encoding)
+}
@@ -42,3 +42,15 @@ func NewError(ctx *gin.Context, status int, err error) {
 	}
 	ctx.JSON(status, er)
 }
+
+// It send the available representations when none of them is acceptable
+func NotAcceptable(ctx *gin.Context, available []string) {
+	er := response.NotAcceptableError{
+		HTTPError: response.HTTPError{
+			Code:    http.StatusNotAcceptable,
+			Message: "none of the available representations is acceptable",
+		},
+		Available: available,
+	}
+	ctx.JSON(http.StatusNotAcceptable, er)
+}
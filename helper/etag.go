@@ -0,0 +1,57 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package helper
+
+import "strings"
+
+// Splits an If-Match or If-None-Match header into its entity tags (e.g. `W/"a", "b"` or `*`)
+func ParseETags(header string) []string {
+	var etags []string
+
+	for header != "" {
+		header = strings.TrimLeft(header, " \t,")
+		if header == "" {
+			break
+		}
+
+		if header[0] == '*' {
+			etags = append(etags, "*")
+			header = header[1:]
+			continue
+		}
+
+		// Entity tags are quoted and may not contain quotes, commas are allowed
+		start := 0
+		if strings.HasPrefix(header, "W/") {
+			start = 2
+		}
+		if len(header) <= start || header[start] != '"' {
+			// Not a valid tag, skip it
+			_, header, _ = strings.Cut(header, ",")
+			continue
+		}
+
+		end := strings.IndexByte(header[start+1:], '"')
+		if end < 0 {
+			break
+		}
+		end += start + 2
+
+		etags = append(etags, header[:end])
+		header = header[end:]
+	}
+
+	return etags
+}
+
+// Compares two entity tags as per RFC 9110, section 8.8.3.2. The weak comparison
+// ignores the W/ prefix, the strong one requires both tags to be strong.
+func ETagMatch(a string, b string, weak bool) bool {
+	if weak {
+		return strings.TrimPrefix(a, "W/") == strings.TrimPrefix(b, "W/")
+	}
+	return !strings.HasPrefix(a, "W/") && !strings.HasPrefix(b, "W/") && a == b
+}
@@ -7,7 +7,6 @@ package helper
 
 import (
 	"ServeBin/data/response"
-	"fmt"
 	"log"
 	"net/http"
 	"runtime"
@@ -110,7 +109,7 @@ func getDiskOperationsAndPartitions() (int64, int64, uint64) {
 					readCount += int64(value.ReadCount)
 					writeCount += int64(value.WriteCount)
 
-					fmt.Println(usage)
+					log.Printf("Disk I/O counters: %v", usage)
 
 					partitionsCount += 1
 					checkedDevices[device] = true // Mark device as checked
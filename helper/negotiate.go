@@ -0,0 +1,126 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package helper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A single entry of an Accept header, e.g. "image/*;q=0.8"
+type MediaRange struct {
+	Type    string
+	Subtype string
+	Params  map[string]string
+	Q       float64
+}
+
+// Reports how specific the range is, an exact match is preferred to a wildcard
+func (r MediaRange) specificity() int {
+	switch {
+	case r.Type == "*":
+		return 0
+	case r.Subtype == "*":
+		return 1
+	default:
+		return 2 + len(r.Params)
+	}
+}
+
+// Reports whether the content type falls within the range
+func (r MediaRange) matches(contentType string) bool {
+	mediaType, subtype, _ := strings.Cut(contentType, "/")
+	return (r.Type == "*" || r.Type == mediaType) && (r.Subtype == "*" || r.Subtype == subtype)
+}
+
+// Parses an Accept header as per RFC 9110, section 12.5.1
+func ParseAccept(header string) []MediaRange {
+	var ranges []MediaRange
+
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+
+		mediaType, subtype, ok := strings.Cut(strings.ToLower(strings.TrimSpace(fields[0])), "/")
+		if !ok || mediaType == "" || subtype == "" {
+			continue
+		}
+
+		mediaRange := MediaRange{
+			Type:    mediaType,
+			Subtype: subtype,
+			Params:  make(map[string]string),
+			Q:       1,
+		}
+
+		for _, param := range fields[1:] {
+			key, value, _ := strings.Cut(param, "=")
+			key = strings.ToLower(strings.TrimSpace(key))
+			value = strings.Trim(strings.TrimSpace(value), `"`)
+
+			if key == "q" {
+				q, err := strconv.ParseFloat(value, 64)
+				if err != nil || q < 0 || q > 1 {
+					q = 0
+				}
+				mediaRange.Q = q
+			} else if key != "" {
+				mediaRange.Params[key] = value
+			}
+		}
+
+		ranges = append(ranges, mediaRange)
+	}
+
+	return ranges
+}
+
+// Picks the offered content type the client prefers. Offers are listed in the
+// order the server prefers them, the first one is returned when Accept is missing.
+func NegotiateContentType(header string, offers []string) (string, bool) {
+	if len(offers) == 0 {
+		return "", false
+	}
+	if strings.TrimSpace(header) == "" {
+		return offers[0], true
+	}
+
+	ranges := ParseAccept(header)
+
+	// The most specific range matching the offer decides its quality
+	type candidate struct {
+		offer       string
+		q           float64
+		specificity int
+	}
+	var candidates []candidate
+
+	for _, offer := range offers {
+		best := candidate{offer: offer, specificity: -1}
+		for _, mediaRange := range ranges {
+			if mediaRange.matches(offer) && mediaRange.specificity() > best.specificity {
+				best.q = mediaRange.Q
+				best.specificity = mediaRange.specificity()
+			}
+		}
+		if best.specificity >= 0 && best.q > 0 {
+			candidates = append(candidates, best)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].q != candidates[j].q {
+			return candidates[i].q > candidates[j].q
+		}
+		return candidates[i].specificity > candidates[j].specificity
+	})
+
+	return candidates[0].offer, true
+}
@@ -6,13 +6,15 @@
 package helper
 
 import (
+	"ServeBin/config"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"net/url"
 	"os"
 	"os/exec"
 	"runtime"
-	"strings"
 )
 
 // Opens the specified URL in the default browser of the user.
@@ -27,42 +29,66 @@ func openBrowser(url string) {
 	case "darwin":
 		err = exec.Command("open", url).Start()
 	default:
-		err = fmt.Errorf("Can't open the server url in the browser! Kindly open it manually " + url)
+		err = fmt.Errorf("unsupported platform %s", runtime.GOOS)
 	}
 	if err != nil {
-		log.Fatal(err)
+		log.Printf("Can't open the server url in the browser (%v), kindly open %s manually", err, url)
 	}
 }
 
-// Get the server Host
-func GetHost() string {
-	host := os.Getenv("HOST")
-	if host == "" {
-		host = "127.0.0.1"
-	}
+// Addresses the listeners are bound to, empty when disabled
+type ServerAddresses struct {
+	URL       string `json:"url"`
+	Addr      string `json:"addr"`
+	HTTPSAddr string `json:"https_addr,omitempty"`
+	HTTP3Addr string `json:"http3_addr,omitempty"`
+	GRPCAddr  string `json:"grpc_addr,omitempty"`
+}
 
+// Reports where the server listens. In headless mode the addresses are
+// printed on stdout as a JSON line, otherwise the URL is logged and opened in
+// the browser during development.
+func AnnounceServer(cfg *config.Config, addresses ServerAddresses) error {
 	var protocol string
-	ssl := os.Getenv("IS_SSL")
-	if strings.ToLower(ssl) == "true" {
+	if cfg.TLS.Enabled {
 		protocol = "https"
 	} else {
 		protocol = "http"
 	}
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8888"
+	addresses.URL = protocol + "://" + connectAddr(cfg.Server.Host, addresses.Addr)
+
+	if cfg.Server.Headless {
+		return json.NewEncoder(os.Stdout).Encode(addresses)
 	}
 
-	addr := host + ":" + port
+	UrlPrint(addresses.Addr, protocol)
+
+	if cfg.Server.Env != "production" {
+		openBrowser(addresses.URL)
+	}
 
-	UrlPrint(addr, protocol)
+	return nil
+}
+
+// Address a client connects to for a listener bound to addr. The loopback
+// interface stands for the unspecified hosts 0.0.0.0 and ::, picked by the
+// configured host as Go reports 0.0.0.0 listeners as [::].
+func connectAddr(host string, addr string) string {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
 
-	if os.Getenv("ENV") != "production" {
-		openBrowser(addr)
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		if ip.To4() != nil {
+			host = "127.0.0.1"
+		} else {
+			host = "::1"
+		}
 	}
 
-	return addr
+	return net.JoinHostPort(host, port)
 }
 
 // Logs the server URL
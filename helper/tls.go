@@ -0,0 +1,228 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package helper
+
+import (
+	"ServeBin/config"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// How often the certificate files are checked for changes
+const certCheckInterval = time.Second
+
+// Builds the TLS config of the HTTPS listeners. The certificate is read from
+// the configured files, and reloaded when they change. A self-signed
+// certificate is generated for host when none is provided.
+func NewTLSConfig(cfg config.TLSConfig, host string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+
+	switch {
+	case cfg.CertFile != "" && cfg.KeyFile != "":
+		reloader, err := NewCertReloader(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.GetCertificate = reloader.GetCertificate
+	case cfg.CertFile != "" || cfg.KeyFile != "":
+		return nil, errors.New("the TLS certificate and key files must be set together")
+	default:
+		cert, err := GenerateSelfSignedCert(host)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("No TLS certificate is configured, serving HTTPS with a self-signed certificate")
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if err := configureClientAuth(tlsConfig, cfg); err != nil {
+		return nil, err
+	}
+
+	return tlsConfig, nil
+}
+
+// Client certificate policies selected with the client_auth setting
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"none":               tls.NoClientCert,
+	"request":            tls.RequestClientCert,
+	"require":            tls.RequireAnyClientCert,
+	"verify_if_given":    tls.VerifyClientCertIfGiven,
+	"require_and_verify": tls.RequireAndVerifyClientCert,
+}
+
+// Asks the clients for a certificate as per the client_auth setting, and
+// verifies it against the configured CA bundle
+func configureClientAuth(tlsConfig *tls.Config, cfg config.TLSConfig) error {
+	clientAuth, ok := clientAuthTypes[cfg.ClientAuth]
+	if !ok {
+		return fmt.Errorf("client_auth must be none, request, require, verify_if_given or require_and_verify, not %q", cfg.ClientAuth)
+	}
+	tlsConfig.ClientAuth = clientAuth
+
+	caFile := cfg.ClientCAFile
+	if caFile == "" {
+		if clientAuth >= tls.VerifyClientCertIfGiven {
+			return fmt.Errorf("a client CA file must be set when client_auth is %s", cfg.ClientAuth)
+		}
+		return nil
+	}
+
+	bundle, err := os.ReadFile(caFile)
+	if err != nil {
+		return err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return fmt.Errorf("no certificate found in %s", caFile)
+	}
+	tlsConfig.ClientCAs = pool
+
+	return nil
+}
+
+// CertReloader serves the certificate of a key pair, reloading the files when
+// they are modified
+type CertReloader struct {
+	certFile string
+	keyFile  string
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+func NewCertReloader(certFile string, keyFile string) (*CertReloader, error) {
+	reloader := &CertReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	modTime, err := reloader.latestModTime()
+	if err != nil {
+		return nil, err
+	}
+	if err := reloader.load(modTime); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// GetCertificate is meant to be used as tls.Config.GetCertificate
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now := time.Now(); now.Sub(r.lastCheck) >= certCheckInterval {
+		r.lastCheck = now
+
+		// Keep serving the current certificate until the new one is complete
+		modTime, err := r.latestModTime()
+		if err == nil && !modTime.Equal(r.modTime) {
+			if err := r.load(modTime); err != nil {
+				log.Printf("Failed to reload the TLS certificate: %v", err)
+			} else {
+				log.Printf("Reloaded the TLS certificate from %s", r.certFile)
+			}
+		}
+	}
+
+	return r.cert, nil
+}
+
+func (r *CertReloader) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	r.cert = &cert
+	r.modTime = modTime
+	return nil
+}
+
+// Returns the modification time of the most recently modified file
+func (r *CertReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// Generates a self-signed certificate for localhost, the loopback addresses
+// and the given host
+func GenerateSelfSignedCert(host string) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"ServeBin"}, CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if !ip.IsUnspecified() && !ip.IsLoopback() {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		}
+	} else if host != "" && host != "localhost" {
+		template.DNSNames = append(template.DNSNames, host)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
@@ -0,0 +1,456 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: servebin.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	ServeBin_Echo_FullMethodName             = "/servebin.v1.ServeBin/Echo"
+	ServeBin_ServerStreamEcho_FullMethodName = "/servebin.v1.ServeBin/ServerStreamEcho"
+	ServeBin_ClientStreamEcho_FullMethodName = "/servebin.v1.ServeBin/ClientStreamEcho"
+	ServeBin_BidiEcho_FullMethodName         = "/servebin.v1.ServeBin/BidiEcho"
+	ServeBin_Status_FullMethodName           = "/servebin.v1.ServeBin/Status"
+	ServeBin_Delay_FullMethodName            = "/servebin.v1.ServeBin/Delay"
+	ServeBin_Metadata_FullMethodName         = "/servebin.v1.ServeBin/Metadata"
+)
+
+// ServeBinClient is the client API for ServeBin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServeBin mirrors the HTTP test endpoints for gRPC clients
+type ServeBinClient interface {
+	// Echo returns the request message
+	Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error)
+	// ServerStreamEcho returns the request message count times
+	ServerStreamEcho(ctx context.Context, in *ServerStreamEchoRequest, opts ...grpc.CallOption) (ServeBin_ServerStreamEchoClient, error)
+	// ClientStreamEcho returns every message received once the client is done
+	ClientStreamEcho(ctx context.Context, opts ...grpc.CallOption) (ServeBin_ClientStreamEchoClient, error)
+	// BidiEcho returns each message as soon as it is received
+	BidiEcho(ctx context.Context, opts ...grpc.CallOption) (ServeBin_BidiEchoClient, error)
+	// Status fails with the requested code, like /status
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Delay replies after the requested number of seconds, like /delay
+	Delay(ctx context.Context, in *DelayRequest, opts ...grpc.CallOption) (*DelayResponse, error)
+	// Metadata returns the incoming metadata, like /headers
+	Metadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetadataResponse, error)
+}
+
+type serveBinClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServeBinClient(cc grpc.ClientConnInterface) ServeBinClient {
+	return &serveBinClient{cc}
+}
+
+func (c *serveBinClient) Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EchoResponse)
+	err := c.cc.Invoke(ctx, ServeBin_Echo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serveBinClient) ServerStreamEcho(ctx context.Context, in *ServerStreamEchoRequest, opts ...grpc.CallOption) (ServeBin_ServerStreamEchoClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServeBin_ServiceDesc.Streams[0], ServeBin_ServerStreamEcho_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serveBinServerStreamEchoClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ServeBin_ServerStreamEchoClient interface {
+	Recv() (*EchoResponse, error)
+	grpc.ClientStream
+}
+
+type serveBinServerStreamEchoClient struct {
+	grpc.ClientStream
+}
+
+func (x *serveBinServerStreamEchoClient) Recv() (*EchoResponse, error) {
+	m := new(EchoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serveBinClient) ClientStreamEcho(ctx context.Context, opts ...grpc.CallOption) (ServeBin_ClientStreamEchoClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServeBin_ServiceDesc.Streams[1], ServeBin_ClientStreamEcho_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serveBinClientStreamEchoClient{ClientStream: stream}
+	return x, nil
+}
+
+type ServeBin_ClientStreamEchoClient interface {
+	Send(*EchoRequest) error
+	CloseAndRecv() (*ClientStreamEchoResponse, error)
+	grpc.ClientStream
+}
+
+type serveBinClientStreamEchoClient struct {
+	grpc.ClientStream
+}
+
+func (x *serveBinClientStreamEchoClient) Send(m *EchoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serveBinClientStreamEchoClient) CloseAndRecv() (*ClientStreamEchoResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ClientStreamEchoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serveBinClient) BidiEcho(ctx context.Context, opts ...grpc.CallOption) (ServeBin_BidiEchoClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServeBin_ServiceDesc.Streams[2], ServeBin_BidiEcho_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serveBinBidiEchoClient{ClientStream: stream}
+	return x, nil
+}
+
+type ServeBin_BidiEchoClient interface {
+	Send(*EchoRequest) error
+	Recv() (*EchoResponse, error)
+	grpc.ClientStream
+}
+
+type serveBinBidiEchoClient struct {
+	grpc.ClientStream
+}
+
+func (x *serveBinBidiEchoClient) Send(m *EchoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serveBinBidiEchoClient) Recv() (*EchoResponse, error) {
+	m := new(EchoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serveBinClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ServeBin_Status_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serveBinClient) Delay(ctx context.Context, in *DelayRequest, opts ...grpc.CallOption) (*DelayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelayResponse)
+	err := c.cc.Invoke(ctx, ServeBin_Delay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serveBinClient) Metadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetadataResponse)
+	err := c.cc.Invoke(ctx, ServeBin_Metadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServeBinServer is the server API for ServeBin service.
+// All implementations must embed UnimplementedServeBinServer
+// for forward compatibility
+//
+// ServeBin mirrors the HTTP test endpoints for gRPC clients
+type ServeBinServer interface {
+	// Echo returns the request message
+	Echo(context.Context, *EchoRequest) (*EchoResponse, error)
+	// ServerStreamEcho returns the request message count times
+	ServerStreamEcho(*ServerStreamEchoRequest, ServeBin_ServerStreamEchoServer) error
+	// ClientStreamEcho returns every message received once the client is done
+	ClientStreamEcho(ServeBin_ClientStreamEchoServer) error
+	// BidiEcho returns each message as soon as it is received
+	BidiEcho(ServeBin_BidiEchoServer) error
+	// Status fails with the requested code, like /status
+	Status(context.Context, *StatusRequest) (*emptypb.Empty, error)
+	// Delay replies after the requested number of seconds, like /delay
+	Delay(context.Context, *DelayRequest) (*DelayResponse, error)
+	// Metadata returns the incoming metadata, like /headers
+	Metadata(context.Context, *emptypb.Empty) (*MetadataResponse, error)
+	mustEmbedUnimplementedServeBinServer()
+}
+
+// UnimplementedServeBinServer must be embedded to have forward compatible implementations.
+type UnimplementedServeBinServer struct {
+}
+
+func (UnimplementedServeBinServer) Echo(context.Context, *EchoRequest) (*EchoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Echo not implemented")
+}
+func (UnimplementedServeBinServer) ServerStreamEcho(*ServerStreamEchoRequest, ServeBin_ServerStreamEchoServer) error {
+	return status.Errorf(codes.Unimplemented, "method ServerStreamEcho not implemented")
+}
+func (UnimplementedServeBinServer) ClientStreamEcho(ServeBin_ClientStreamEchoServer) error {
+	return status.Errorf(codes.Unimplemented, "method ClientStreamEcho not implemented")
+}
+func (UnimplementedServeBinServer) BidiEcho(ServeBin_BidiEchoServer) error {
+	return status.Errorf(codes.Unimplemented, "method BidiEcho not implemented")
+}
+func (UnimplementedServeBinServer) Status(context.Context, *StatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedServeBinServer) Delay(context.Context, *DelayRequest) (*DelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delay not implemented")
+}
+func (UnimplementedServeBinServer) Metadata(context.Context, *emptypb.Empty) (*MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Metadata not implemented")
+}
+func (UnimplementedServeBinServer) mustEmbedUnimplementedServeBinServer() {}
+
+// UnsafeServeBinServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServeBinServer will
+// result in compilation errors.
+type UnsafeServeBinServer interface {
+	mustEmbedUnimplementedServeBinServer()
+}
+
+func RegisterServeBinServer(s grpc.ServiceRegistrar, srv ServeBinServer) {
+	s.RegisterService(&ServeBin_ServiceDesc, srv)
+}
+
+func _ServeBin_Echo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EchoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServeBinServer).Echo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServeBin_Echo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServeBinServer).Echo(ctx, req.(*EchoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServeBin_ServerStreamEcho_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerStreamEchoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServeBinServer).ServerStreamEcho(m, &serveBinServerStreamEchoServer{ServerStream: stream})
+}
+
+type ServeBin_ServerStreamEchoServer interface {
+	Send(*EchoResponse) error
+	grpc.ServerStream
+}
+
+type serveBinServerStreamEchoServer struct {
+	grpc.ServerStream
+}
+
+func (x *serveBinServerStreamEchoServer) Send(m *EchoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ServeBin_ClientStreamEcho_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServeBinServer).ClientStreamEcho(&serveBinClientStreamEchoServer{ServerStream: stream})
+}
+
+type ServeBin_ClientStreamEchoServer interface {
+	SendAndClose(*ClientStreamEchoResponse) error
+	Recv() (*EchoRequest, error)
+	grpc.ServerStream
+}
+
+type serveBinClientStreamEchoServer struct {
+	grpc.ServerStream
+}
+
+func (x *serveBinClientStreamEchoServer) SendAndClose(m *ClientStreamEchoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serveBinClientStreamEchoServer) Recv() (*EchoRequest, error) {
+	m := new(EchoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ServeBin_BidiEcho_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServeBinServer).BidiEcho(&serveBinBidiEchoServer{ServerStream: stream})
+}
+
+type ServeBin_BidiEchoServer interface {
+	Send(*EchoResponse) error
+	Recv() (*EchoRequest, error)
+	grpc.ServerStream
+}
+
+type serveBinBidiEchoServer struct {
+	grpc.ServerStream
+}
+
+func (x *serveBinBidiEchoServer) Send(m *EchoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serveBinBidiEchoServer) Recv() (*EchoRequest, error) {
+	m := new(EchoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ServeBin_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServeBinServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServeBin_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServeBinServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServeBin_Delay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServeBinServer).Delay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServeBin_Delay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServeBinServer).Delay(ctx, req.(*DelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServeBin_Metadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServeBinServer).Metadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServeBin_Metadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServeBinServer).Metadata(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServeBin_ServiceDesc is the grpc.ServiceDesc for ServeBin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServeBin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "servebin.v1.ServeBin",
+	HandlerType: (*ServeBinServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Echo",
+			Handler:    _ServeBin_Echo_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _ServeBin_Status_Handler,
+		},
+		{
+			MethodName: "Delay",
+			Handler:    _ServeBin_Delay_Handler,
+		},
+		{
+			MethodName: "Metadata",
+			Handler:    _ServeBin_Metadata_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ServerStreamEcho",
+			Handler:       _ServeBin_ServerStreamEcho_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ClientStreamEcho",
+			Handler:       _ServeBin_ClientStreamEcho_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BidiEcho",
+			Handler:       _ServeBin_BidiEcho_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "servebin.proto",
+}
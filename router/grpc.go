@@ -0,0 +1,22 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"ServeBin/controller"
+	"ServeBin/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewGRPCServer registers the ServeBin service along with the reflection service
+func NewGRPCServer(grpcController *controller.GRPCController) *grpc.Server {
+	server := grpc.NewServer()
+	pb.RegisterServeBinServer(server, grpcController)
+	reflection.Register(server)
+
+	return server
+}
@@ -7,23 +7,25 @@ package router
 
 import (
 	"ServeBin"
+	"ServeBin/config"
 	"ServeBin/controller"
 	"ServeBin/middleware"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"net/http"
-	"os"
+	"strings"
 )
 
-func NewRouter(apiController *controller.APIController) *gin.Engine {
+func NewRouter(apiController *controller.APIController, cfg *config.Config) *gin.Engine {
 	router := gin.Default()
 
 	router.Use(middleware.CORSMiddleware())
+	router.Use(apiController.Inspect)
 
 	router.LoadHTMLGlob("templates/**/*")
 
-	if os.Getenv("IS_BACKUP_SERVER") == "true" {
+	if cfg.Server.IsBackupServer {
 		// Redirect to the Main Server
 		router.GET("", apiController.Redirect)
 		router.GET("/docs/*any", apiController.Redirect)
@@ -50,13 +52,17 @@ func NewRouter(apiController *controller.APIController) *gin.Engine {
 	router.StaticFile("/favicon.ico", "./static/logo/favicon.ico")
 	router.GET("/about", apiController.About)
 	router.GET("/heartbeat", apiController.HeartBeat)
+	router.GET("/ready", apiController.Ready)
 
 	router.GET("/ip", apiController.GetIP)
 	router.GET("/headers", apiController.GetHeaders)
+	router.GET("/client-cert", apiController.GetClientCert)
 	router.GET("/user-agent", apiController.GetUserAgent)
 
 	router.GET("/status", apiController.GetStatusCodes)
 	router.Any("/status/:statuscode", apiController.GetStatusCodes)
+	router.GET("/response-headers", apiController.ResponseHeaders)
+	router.POST("/response-headers", apiController.ResponseHeaders)
 
 	router.GET("/image", apiController.GetImages)
 	router.GET("/image/:imagetype", apiController.GetImages)
@@ -64,11 +70,13 @@ func NewRouter(apiController *controller.APIController) *gin.Engine {
 	router.GET("/xml", apiController.GetXML)
 	router.GET("/html", apiController.GetHTML)
 	router.GET("/json", apiController.GetJson)
+	router.GET("/negotiate", apiController.Negotiate)
 	router.GET("/deny", apiController.GetDenyPath)
 	router.GET("/gzip", apiController.Getgzip)
 	router.GET("/brotli", apiController.Getbrotli)
 	router.GET("/deflate", apiController.Getdeflate)
 	router.GET("/zstd", apiController.Getzstd)
+	router.GET("/compressed", apiController.Compressed)
 	router.GET("/robots.txt", apiController.GetRobotsTxt)
 
 	router.HEAD("/head", apiController.ResponseHeaderData)
@@ -79,5 +87,52 @@ func NewRouter(apiController *controller.APIController) *gin.Engine {
 	router.PATCH("/patch", apiController.ResponseBodyData)
 	router.OPTIONS("/options", apiController.ResponseHeaderData)
 
+	router.Any("/anything", apiController.Anything)
+	router.Any("/anything/*path", apiController.Anything)
+	// The verbs router.Any doesn't know, like PROPFIND, QUERY or custom ones,
+	// fall through to NoRoute
+	router.NoRoute(func(ctx *gin.Context) {
+		path := ctx.Request.URL.Path
+		if path == "/anything" || strings.HasPrefix(path, "/anything/") {
+			apiController.Anything(ctx)
+		}
+	})
+
+	router.GET("/cache", apiController.Cache)
+	router.GET("/cache/:value", apiController.CacheControl)
+	router.GET("/etag/:etag", apiController.ETag)
+
+	router.GET("/redirect/:n", apiController.RedirectN)
+	router.GET("/relative-redirect/:n", apiController.RelativeRedirect)
+	router.GET("/absolute-redirect/:n", apiController.AbsoluteRedirect)
+	router.Any("/redirect-to", apiController.RedirectTo)
+
+	router.GET("/cookies", apiController.GetCookies)
+	router.GET("/cookies/set", apiController.SetCookies)
+	router.GET("/cookies/delete", apiController.DeleteCookies)
+	router.GET("/cookies/set-with-attributes", apiController.SetCookieWithAttributes)
+
+	router.GET("/basic-auth/:user/:passwd", apiController.BasicAuth)
+	router.GET("/hidden-basic-auth/:user/:passwd", apiController.HiddenBasicAuth)
+	router.GET("/bearer", apiController.BearerAuth)
+	router.GET("/digest-auth/:qop/:user/:passwd", apiController.DigestAuth)
+	router.GET("/digest-auth/:qop/:user/:passwd/:algorithm", apiController.DigestAuth)
+
+	router.POST("/bins", apiController.CreateBin)
+	router.Any("/bins/:id", apiController.BinRequest)
+	router.Any("/bins/:id/*path", apiController.BinRequest)
+
+	router.Any("/delay/:seconds", apiController.Delay)
+	router.GET("/stream/:n", apiController.Stream)
+	router.GET("/drip", apiController.Drip)
+	router.GET("/sse", apiController.SSE)
+	router.GET("/stream-bytes/:n", apiController.StreamBytes)
+	router.GET("/bytes/:n", apiController.Bytes)
+	router.GET("/range/:n", apiController.Range)
+
+	router.GET("/inspect/stream", apiController.InspectStream)
+	router.GET("/inspect/ws", apiController.InspectWebSocket)
+	router.GET("/ws/echo", apiController.WebSocketEcho)
+
 	return router
 }
@@ -0,0 +1,123 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package router
+
+import (
+	"context"
+	"github.com/quic-go/quic-go/http3"
+	"golang.org/x/net/http/httpguts"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// NewServerHandler serves the HTTP routes, and the gRPC requests too unless
+// grpcServer is nil. Cleartext connections can speak HTTP/2 either with prior
+// knowledge or by upgrading from HTTP/1.1 (h2c). Every request is counted by
+// requests, the h2c connections themselves are not.
+func NewServerHandler(httpHandler http.Handler, grpcServer *grpc.Server, requests *RequestTracker) http.Handler {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isH2CUpgrade(r) {
+			upgradedRequest(r)
+		}
+
+		if grpcServer != nil && r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+			return
+		}
+		httpHandler.ServeHTTP(w, r)
+	})
+
+	return h2c.NewHandler(requests.Handler(handler), &http2.Server{})
+}
+
+// Reports whether the request asked to upgrade to h2c, RFC 7540 section 3.2
+func isH2CUpgrade(r *http.Request) bool {
+	return r.ProtoMajor == 1 &&
+		httpguts.HeaderValuesContainsToken(r.Header["Upgrade"], "h2c") &&
+		httpguts.HeaderValuesContainsToken(r.Header["Connection"], "HTTP2-Settings")
+}
+
+// The h2c handler answers the upgrade request on the first HTTP/2 stream but
+// leaves it as it was received, so make it look like it came over HTTP/2
+func upgradedRequest(r *http.Request) {
+	r.Proto = "HTTP/2.0"
+	r.ProtoMajor = 2
+	r.ProtoMinor = 0
+
+	// Connection specific headers are not allowed in HTTP/2
+	r.Header.Del("Connection")
+	r.Header.Del("Upgrade")
+	r.Header.Del("Http2-Settings")
+}
+
+// NewAltSvcHandler advertises the HTTP/3 server to the clients connected over
+// TLS, RFC 7838. Alternative services are ignored for cleartext origins.
+func NewAltSvcHandler(handler http.Handler, http3Server *http3.Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && r.ProtoMajor < 3 {
+			// Fails until the HTTP/3 listener is up
+			http3Server.SetQUICHeaders(w.Header())
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// RequestTracker counts the requests being served. The HTTP servers lose track
+// of the h2c and WebSocket connections they hand over to other handlers, so
+// the shutdown waits on the tracker to drain them too.
+type RequestTracker struct {
+	mu     sync.Mutex
+	active int
+	idle   chan struct{}
+}
+
+// Handler counts the requests served by handler
+func (t *RequestTracker) Handler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.mu.Lock()
+		t.active++
+		t.mu.Unlock()
+
+		defer t.done()
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (t *RequestTracker) done() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.active--
+	if t.active == 0 && t.idle != nil {
+		close(t.idle)
+		t.idle = nil
+	}
+}
+
+// Wait blocks until no request is being served or ctx is done
+func (t *RequestTracker) Wait(ctx context.Context) error {
+	t.mu.Lock()
+	if t.active == 0 {
+		t.mu.Unlock()
+		return nil
+	}
+	if t.idle == nil {
+		t.idle = make(chan struct{})
+	}
+	idle := t.idle
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -6,11 +6,17 @@
 package service
 
 import (
+	"ServeBin/data/request"
+	"ServeBin/data/response"
 	"github.com/gin-gonic/gin"
+	"io"
+	"time"
 )
 
 type APIService interface {
 	FindIP(ctx *gin.Context) string
+	ReturnConnection(ctx *gin.Context) response.ConnectionResponse
+	ReturnClientCert(ctx *gin.Context) (response.ClientCertResponse, error)
 	GeneratePNG() ([]byte, error)
 	GenerateJPEG() ([]byte, error)
 	GenerateSVG() ([]byte, error)
@@ -25,4 +31,32 @@ type APIService interface {
 	ReturnFormData(ctx *gin.Context) (map[string]interface{}, error)
 	ReturnFormFile(ctx *gin.Context) (map[string]interface{}, error)
 	ReturnJson_RawData(ctx *gin.Context) (map[string]interface{}, error)
+	CreateBin() (response.BinResponse, error)
+	RecordBinRequest(binID string, data response.BodyDataResponse) (response.BinRequestResponse, error)
+	ListBinRequests(binID string, req request.BinRequestsRequest) (response.BinRequestsResponse, error)
+	SubscribeRequests(bin string, path string) *Subscription
+	UnsubscribeRequests(subscription *Subscription)
+	IsPathWatched(path string) bool
+	PublishRequest(bin string, path string, data response.BodyDataResponse, truncated bool)
+	ComputeDelay(req request.DelayRequest) (time.Duration, error)
+	ValidateRedirect(req request.RedirectToRequest) error
+	ReturnCookies(ctx *gin.Context) map[string]string
+	BuildCookie(req request.CookieAttributesRequest) (string, error)
+	ValidateBasicAuth(ctx *gin.Context, user string, passwd string) error
+	ValidateBearerToken(ctx *gin.Context) (string, error)
+	DigestChallenge(req request.DigestAuthRequest, stale bool) string
+	ValidateDigestAuth(ctx *gin.Context, req request.DigestAuthRequest) error
+	PlanDrip(req request.DripRequest) (time.Duration, time.Duration, error)
+	GenerateBytes(req request.BytesRequest) (io.Reader, int64, error)
+	StreamBytes(req request.StreamBytesRequest) (io.Reader, int64, error)
+	GenerateRangeContent(n int) ([]byte, error)
+	CheckETag(ctx *gin.Context, etag string) int
+	ChooseStatusCode(codes string) (int, error)
+	ReturnStatusHeaders(status int) map[string]string
+	ChooseEncodings(ctx *gin.Context, req request.CompressedRequest) ([]string, error)
+	DecodeRequestBody(ctx *gin.Context) (int64, int64, error)
+	PlanWebSocketEcho(req request.WebSocketEchoRequest) (time.Duration, time.Duration, error)
+	PlanSSE(req request.SSERequest, lastEventID string) (int, time.Duration, error)
+	StartDraining()
+	IsDraining() bool
 }
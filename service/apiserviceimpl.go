@@ -6,17 +6,28 @@
 package service
 
 import (
+	"ServeBin/config"
+	"ServeBin/data/response"
+	"crypto/tls"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"sync/atomic"
 )
 
 type APIServiceImpl struct {
-	Validate *validator.Validate
+	Validate    *validator.Validate
+	config      *config.Config
+	storage     Storage
+	broadcaster *Broadcaster
+	draining    atomic.Bool
 }
 
-func NewAPIServiceImpl(validate *validator.Validate) APIService {
+func NewAPIServiceImpl(validate *validator.Validate, cfg *config.Config, storage Storage) APIService {
 	return &APIServiceImpl{
-		Validate: validate,
+		Validate:    validate,
+		config:      cfg,
+		storage:     storage,
+		broadcaster: NewBroadcaster(),
 	}
 }
 
@@ -26,3 +37,17 @@ func (t *APIServiceImpl) FindIP(ctx *gin.Context) string {
 
 	return ip
 }
+
+// ReturnConnection implements APIService
+func (t *APIServiceImpl) ReturnConnection(ctx *gin.Context) response.ConnectionResponse {
+	connection := response.ConnectionResponse{
+		Protocol: ctx.Request.Proto,
+	}
+
+	if state := ctx.Request.TLS; state != nil {
+		connection.TLSVersion = tls.VersionName(state.Version)
+		connection.CipherSuite = tls.CipherSuiteName(state.CipherSuite)
+	}
+
+	return connection
+}
@@ -0,0 +1,213 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	// Realm sent in every authentication challenge
+	authRealm = "ServeBin"
+
+	// Digest nonces older than this are reported as stale
+	digestNonceLifetime = 5 * time.Minute
+)
+
+var (
+	// ErrUnauthorized is returned when the credentials are missing or wrong
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrStaleNonce is returned when the digest credentials use an expired nonce
+	ErrStaleNonce = errors.New("stale nonce")
+)
+
+// Key signing the digest nonces, nonces don't survive a restart
+var nonceKey = func() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+// ValidateBasicAuth implements APIService
+func (t *APIServiceImpl) ValidateBasicAuth(ctx *gin.Context, user string, passwd string) error {
+	givenUser, givenPasswd, ok := ctx.Request.BasicAuth()
+	if !ok || !secureCompare(givenUser, user) || !secureCompare(givenPasswd, passwd) {
+		return ErrUnauthorized
+	}
+	return nil
+}
+
+// ValidateBearerToken implements APIService
+func (t *APIServiceImpl) ValidateBearerToken(ctx *gin.Context) (string, error) {
+	scheme, token, ok := strings.Cut(ctx.GetHeader("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", ErrUnauthorized
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// DigestChallenge implements APIService
+func (t *APIServiceImpl) DigestChallenge(req request.DigestAuthRequest, stale bool) string {
+	challenge := fmt.Sprintf(`Digest realm="%s", qop="%s", algorithm=%s, nonce="%s", opaque="%s"`,
+		authRealm, req.Qop, req.Algorithm, newNonce(time.Now()), digestOpaque())
+	if stale {
+		challenge += ", stale=true"
+	}
+	return challenge
+}
+
+// ValidateDigestAuth implements APIService
+func (t *APIServiceImpl) ValidateDigestAuth(ctx *gin.Context, req request.DigestAuthRequest) error {
+	if err := t.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	scheme, credentials, ok := strings.Cut(ctx.GetHeader("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Digest") {
+		return ErrUnauthorized
+	}
+	params := parseAuthParams(credentials)
+
+	if params["username"] != req.User || params["realm"] != authRealm ||
+		params["qop"] != req.Qop || params["uri"] != ctx.Request.RequestURI ||
+		params["nc"] == "" || params["cnonce"] == "" || params["opaque"] != digestOpaque() {
+		return ErrUnauthorized
+	}
+	if algorithm, ok := params["algorithm"]; ok && !strings.EqualFold(algorithm, req.Algorithm) {
+		return ErrUnauthorized
+	}
+
+	newHash := md5.New
+	if strings.HasPrefix(req.Algorithm, "SHA-256") {
+		newHash = sha256.New
+	}
+	h := func(data string) string {
+		digest := newHash()
+		io.WriteString(digest, data)
+		return hex.EncodeToString(digest.Sum(nil))
+	}
+
+	// RFC 7616, section 3.4.2
+	ha1 := h(req.User + ":" + authRealm + ":" + req.Passwd)
+	if strings.HasSuffix(req.Algorithm, "-sess") {
+		ha1 = h(ha1 + ":" + params["nonce"] + ":" + params["cnonce"])
+	}
+
+	// RFC 7616, section 3.4.3
+	ha2 := h(ctx.Request.Method + ":" + params["uri"])
+	if req.Qop == "auth-int" {
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			return err
+		}
+		ha2 = h(ctx.Request.Method + ":" + params["uri"] + ":" + h(string(body)))
+	}
+
+	expected := h(strings.Join([]string{ha1, params["nonce"], params["nc"], params["cnonce"], req.Qop, ha2}, ":"))
+	if !secureCompare(params["response"], expected) {
+		return ErrUnauthorized
+	}
+
+	// Only report a stale nonce to clients knowing the password
+	issued, err := checkNonce(params["nonce"])
+	if err != nil {
+		return ErrUnauthorized
+	}
+	if time.Since(issued) > digestNonceLifetime {
+		return ErrStaleNonce
+	}
+
+	return nil
+}
+
+func secureCompare(given string, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(given), []byte(expected)) == 1
+}
+
+// Signs the issue time so that the nonce can be checked without keeping any state
+func newNonce(issued time.Time) string {
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(issued.UnixNano()))
+	return base64.RawURLEncoding.EncodeToString(append(timestamp, sign(timestamp)...))
+}
+
+// Returns the issue time of a nonce signed by this server
+func checkNonce(nonce string) (time.Time, error) {
+	data, err := base64.RawURLEncoding.DecodeString(nonce)
+	if err != nil || len(data) <= 8 {
+		return time.Time{}, ErrUnauthorized
+	}
+
+	timestamp, signature := data[:8], data[8:]
+	if !hmac.Equal(signature, sign(timestamp)) {
+		return time.Time{}, ErrUnauthorized
+	}
+
+	return time.Unix(0, int64(binary.BigEndian.Uint64(timestamp))), nil
+}
+
+func digestOpaque() string {
+	return hex.EncodeToString(sign([]byte("opaque")))
+}
+
+func sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, nonceKey)
+	mac.Write(data)
+	return mac.Sum(nil)[:16]
+}
+
+// Parses the comma separated key=value pairs of an Authorization header
+func parseAuthParams(header string) map[string]string {
+	params := make(map[string]string)
+
+	for header != "" {
+		header = strings.TrimLeft(header, " ,")
+		key, rest, ok := strings.Cut(header, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			// Quoted value, may contain commas and escaped characters
+			var builder strings.Builder
+			i := 1
+			for ; i < len(rest) && rest[i] != '"'; i++ {
+				if rest[i] == '\\' && i+1 < len(rest) {
+					i++
+				}
+				builder.WriteByte(rest[i])
+			}
+			value = builder.String()
+			header = rest[min(i+1, len(rest)):]
+		} else {
+			value, header, _ = strings.Cut(rest, ",")
+			value = strings.TrimSpace(value)
+		}
+
+		params[key] = value
+	}
+
+	return params
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/data/response"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Generates a random hex encoded identifier
+func newID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// CreateBin implements APIService
+func (t *APIServiceImpl) CreateBin() (response.BinResponse, error) {
+	id, err := newID()
+	if err != nil {
+		return response.BinResponse{}, err
+	}
+
+	bin := response.BinResponse{
+		ID:        id,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := t.storage.CreateBin(bin); err != nil {
+		return response.BinResponse{}, err
+	}
+
+	return bin, nil
+}
+
+// RecordBinRequest implements APIService
+func (t *APIServiceImpl) RecordBinRequest(binID string, data response.BodyDataResponse) (response.BinRequestResponse, error) {
+	id, err := newID()
+	if err != nil {
+		return response.BinRequestResponse{}, err
+	}
+
+	record := response.BinRequestResponse{
+		ID:               id,
+		Timestamp:        time.Now().UTC(),
+		BodyDataResponse: data,
+	}
+
+	if err := t.storage.AppendRequest(binID, record); err != nil {
+		return response.BinRequestResponse{}, err
+	}
+
+	return record, nil
+}
+
+// ListBinRequests implements APIService
+func (t *APIServiceImpl) ListBinRequests(binID string, req request.BinRequestsRequest) (response.BinRequestsResponse, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return response.BinRequestsResponse{}, err
+	}
+
+	requests, total, err := t.storage.ListRequests(binID, (req.Page-1)*req.Limit, req.Limit)
+	if err != nil {
+		return response.BinRequestsResponse{}, err
+	}
+
+	return response.BinRequestsResponse{
+		BinID:    binID,
+		Page:     req.Page,
+		Limit:    req.Limit,
+		Total:    total,
+		Requests: requests,
+	}, nil
+}
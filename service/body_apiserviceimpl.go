@@ -0,0 +1,74 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/helper"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+)
+
+// DecodeRequestBody implements APIService
+func (t *APIServiceImpl) DecodeRequestBody(ctx *gin.Context) (int64, int64, error) {
+	if ctx.Request.Body == nil {
+		return 0, 0, nil
+	}
+
+	encodings := helper.ParseContentEncoding(ctx.GetHeader("Content-Encoding"))
+	if len(encodings) == 0 {
+		raw, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			return 0, 0, err
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(raw))
+
+		return int64(len(raw)), int64(len(raw)), nil
+	}
+
+	// Read one more byte than allowed to tell a body at the limit from a bigger one
+	maxSize := t.config.Limits.MaxDecompressedBody
+	raw, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxSize+1))
+	if err != nil {
+		return 0, 0, err
+	}
+	if int64(len(raw)) > maxSize {
+		return 0, 0, fmt.Errorf("%w, compressed bodies are limited to %d bytes", helper.ErrBodyTooLarge, maxSize)
+	}
+	ctx.Request.Body = io.NopCloser(bytes.NewReader(raw))
+
+	if len(raw) == 0 {
+		return 0, 0, nil
+	}
+
+	// Codings are listed in the order they were applied, so undo them backwards
+	var reader io.Reader = bytes.NewReader(raw)
+	for i := len(encodings) - 1; i >= 0; i-- {
+		decoder, err := helper.NewDecoder(reader, encodings[i])
+		if errors.Is(err, helper.ErrUnsupportedEncoding) {
+			return 0, 0, err
+		} else if err != nil {
+			return 0, 0, fmt.Errorf("failed to decode the body: %w", err)
+		}
+		defer decoder.Close()
+		reader = decoder
+	}
+
+	decoded, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to decode the body: %w", err)
+	}
+	if int64(len(decoded)) > maxSize {
+		return 0, 0, fmt.Errorf("%w, decompressed bodies are limited to %d bytes", helper.ErrBodyTooLarge, maxSize)
+	}
+
+	ctx.Request.Body = io.NopCloser(bytes.NewReader(decoded))
+	ctx.Request.ContentLength = int64(len(decoded))
+
+	return int64(len(raw)), int64(len(decoded)), nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/response"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// Bucket holding the binMeta of every bin
+	binsMetaBucket = []byte("bins_meta")
+
+	// Bucket holding a nested bucket of requests per bin
+	binsRequestsBucket = []byte("bins_requests")
+)
+
+// BoltStorage keeps the bins in a single BoltDB file, they survive restarts
+type BoltStorage struct {
+	options StorageOptions
+	db      *bolt.DB
+	done    chan struct{}
+}
+
+func NewBoltStorage(options StorageOptions) (*BoltStorage, error) {
+	if options.Path == "" {
+		options.Path = "servebin.db"
+	}
+
+	db, err := bolt.Open(options.Path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(binsMetaBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(binsRequestsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	storage := &BoltStorage{
+		options: options,
+		db:      db,
+		done:    make(chan struct{}),
+	}
+
+	go runJanitor(options.TTL, storage.done, storage.evict)
+
+	return storage, nil
+}
+
+// Encodes the sequence so that the keys sort in insertion order
+func sequenceKey(sequence uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+	return key
+}
+
+func getBinMeta(tx *bolt.Tx, binID string) (binMeta, error) {
+	var meta binMeta
+
+	data := tx.Bucket(binsMetaBucket).Get([]byte(binID))
+	if data == nil {
+		return meta, ErrBinNotFound
+	}
+
+	err := json.Unmarshal(data, &meta)
+	return meta, err
+}
+
+func putBinMeta(tx *bolt.Tx, binID string, meta binMeta) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(binsMetaBucket).Put([]byte(binID), data)
+}
+
+// CreateBin implements Storage
+func (s *BoltStorage) CreateBin(bin response.BinResponse) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if s.options.MaxBins > 0 && tx.Bucket(binsMetaBucket).Stats().KeyN >= s.options.MaxBins {
+			return ErrStorageFull
+		}
+
+		if _, err := tx.Bucket(binsRequestsBucket).CreateBucketIfNotExists([]byte(bin.ID)); err != nil {
+			return err
+		}
+
+		return putBinMeta(tx, bin.ID, binMeta{CreatedAt: bin.CreatedAt, LastSeen: bin.CreatedAt})
+	})
+}
+
+// AppendRequest implements Storage
+func (s *BoltStorage) AppendRequest(binID string, record response.BinRequestResponse) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		meta, err := getBinMeta(tx, binID)
+		if err != nil {
+			return err
+		}
+
+		requests := tx.Bucket(binsRequestsBucket).Bucket([]byte(binID))
+		if requests == nil {
+			return ErrBinNotFound
+		}
+
+		if err := s.options.checkRequestSize(record); err != nil {
+			return err
+		}
+
+		sequence, err := requests.NextSequence()
+		if err != nil {
+			return err
+		}
+		if err := requests.Put(sequenceKey(sequence), data); err != nil {
+			return err
+		}
+		meta.Count++
+
+		// Drop the oldest requests above the cap
+		if excess := meta.Count - s.options.MaxRequests; s.options.MaxRequests > 0 && excess > 0 {
+			removed, err := deleteOldest(requests, func(key, value []byte) (bool, error) {
+				excess--
+				return excess >= 0, nil
+			})
+			meta.Count -= removed
+			if err != nil {
+				return err
+			}
+		}
+
+		meta.LastSeen = record.Timestamp
+		return putBinMeta(tx, binID, meta)
+	})
+}
+
+// ListRequests implements Storage
+func (s *BoltStorage) ListRequests(binID string, offset int, limit int) ([]response.BinRequestResponse, int, error) {
+	requests := make([]response.BinRequestResponse, 0, limit)
+	var total int
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		meta, err := getBinMeta(tx, binID)
+		if err != nil {
+			return err
+		}
+		total = meta.Count
+
+		bucket := tx.Bucket(binsRequestsBucket).Bucket([]byte(binID))
+		if bucket == nil {
+			return ErrBinNotFound
+		}
+
+		// Newest requests come first
+		cursor := bucket.Cursor()
+		skipped := 0
+		for key, value := cursor.Last(); key != nil && len(requests) < limit; key, value = cursor.Prev() {
+			if skipped < offset {
+				skipped++
+				continue
+			}
+
+			var record response.BinRequestResponse
+			if err := json.Unmarshal(value, &record); err != nil {
+				return err
+			}
+			requests = append(requests, record)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return requests, total, nil
+}
+
+// Close implements Storage
+func (s *BoltStorage) Close() error {
+	close(s.done)
+	return s.db.Close()
+}
+
+// Deletes the requests from the oldest one for as long as drop returns true
+func deleteOldest(requests *bolt.Bucket, drop func(key, value []byte) (bool, error)) (int, error) {
+	var keys [][]byte
+
+	cursor := requests.Cursor()
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		ok, err := drop(key, value)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			break
+		}
+		keys = append(keys, key)
+	}
+
+	for i, key := range keys {
+		if err := requests.Delete(key); err != nil {
+			return i, err
+		}
+	}
+
+	return len(keys), nil
+}
+
+// Drops the idle bins and the requests older than the TTL
+func (s *BoltStorage) evict(now time.Time) {
+	_ = s.db.Update(func(tx *bolt.Tx) error {
+		metaBucket := tx.Bucket(binsMetaBucket)
+		requestsBucket := tx.Bucket(binsRequestsBucket)
+
+		metas := make(map[string]binMeta)
+		err := metaBucket.ForEach(func(key, value []byte) error {
+			var meta binMeta
+			if err := json.Unmarshal(value, &meta); err != nil {
+				return err
+			}
+			metas[string(key)] = meta
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for binID, meta := range metas {
+			if meta.expired(s.options.TTL, now) {
+				if err := metaBucket.Delete([]byte(binID)); err != nil {
+					return err
+				}
+				if err := requestsBucket.DeleteBucket([]byte(binID)); err != nil && err != bolt.ErrBucketNotFound {
+					return err
+				}
+				continue
+			}
+
+			requests := requestsBucket.Bucket([]byte(binID))
+			if requests == nil {
+				continue
+			}
+
+			// Requests are stored oldest first, stop at the first fresh one
+			removed, err := deleteOldest(requests, func(key, value []byte) (bool, error) {
+				var record response.BinRequestResponse
+				if err := json.Unmarshal(value, &record); err != nil {
+					return false, err
+				}
+				return now.Sub(record.Timestamp) > s.options.TTL, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if removed > 0 {
+				meta.Count -= removed
+				if err := putBinMeta(tx, binID, meta); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/response"
+	"strings"
+	"sync"
+)
+
+const (
+	// Number of events buffered per subscriber
+	subscriberBufferSize = 64
+
+	// Consecutive events dropped before a slow subscriber is disconnected
+	subscriberMaxDropped = 256
+)
+
+// Subscription receives the inspected requests matching its bin or path
+type Subscription struct {
+	// Events is closed once the subscription ends
+	Events <-chan response.InspectEventResponse
+
+	events  chan response.InspectEventResponse
+	bin     string
+	path    string
+	dropped int
+
+	// Dropped is set when the subscriber got disconnected for being too slow
+	Dropped bool
+}
+
+// Reports whether the event should be delivered to the subscriber
+func (s *Subscription) matches(event response.InspectEventResponse) bool {
+	if s.bin != "" {
+		return s.bin == event.Bin
+	}
+	return event.Bin == "" && strings.HasPrefix(event.Path, s.path)
+}
+
+// Broadcaster fans the inspected requests out to every subscriber
+type Broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe watches a bin, or every request whose path starts with path
+func (b *Broadcaster) Subscribe(bin string, path string) *Subscription {
+	events := make(chan response.InspectEventResponse, subscriberBufferSize)
+	subscription := &Subscription{
+		Events: events,
+		events: events,
+		bin:    bin,
+		path:   path,
+	}
+
+	b.mu.Lock()
+	b.subscribers[subscription] = struct{}{}
+	b.mu.Unlock()
+
+	return subscription
+}
+
+// Unsubscribe ends the subscription and closes its Events channel
+func (b *Broadcaster) Unsubscribe(subscription *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.remove(subscription)
+}
+
+func (b *Broadcaster) remove(subscription *Subscription) {
+	if _, ok := b.subscribers[subscription]; ok {
+		delete(b.subscribers, subscription)
+		close(subscription.events)
+	}
+}
+
+// Watching reports whether anyone is subscribed to the path
+func (b *Broadcaster) Watching(path string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscription := range b.subscribers {
+		if subscription.bin == "" && strings.HasPrefix(path, subscription.path) {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish delivers the event without blocking. Events are dropped for
+// subscribers whose buffer is full, and subscribers that keep falling
+// behind are disconnected.
+func (b *Broadcaster) Publish(event response.InspectEventResponse) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscription := range b.subscribers {
+		if !subscription.matches(event) {
+			continue
+		}
+
+		select {
+		case subscription.events <- event:
+			subscription.dropped = 0
+		default:
+			subscription.dropped++
+			if subscription.dropped >= subscriberMaxDropped {
+				subscription.Dropped = true
+				b.remove(subscription)
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/helper"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// CheckETag implements APIService
+func (t *APIServiceImpl) CheckETag(ctx *gin.Context, etag string) int {
+	// If-Match is evaluated first and uses the strong comparison, RFC 9110
+	// sections 13.1.1 and 13.2.2
+	if header := ctx.GetHeader("If-Match"); header != "" {
+		matched := false
+		for _, tag := range helper.ParseETags(header) {
+			if tag == "*" || helper.ETagMatch(tag, etag, false) {
+				matched = true
+			}
+		}
+		if !matched {
+			return http.StatusPreconditionFailed
+		}
+	}
+
+	// If-None-Match uses the weak comparison, RFC 9110 section 13.1.2
+	if header := ctx.GetHeader("If-None-Match"); header != "" {
+		for _, tag := range helper.ParseETags(header) {
+			if tag == "*" || helper.ETagMatch(tag, etag, true) {
+				return http.StatusNotModified
+			}
+		}
+	}
+
+	return http.StatusOK
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/config"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	apiService := NewAPIServiceImpl(validator.New(), config.Default(), nil)
+
+	tests := []struct {
+		etag        string
+		ifMatch     string
+		ifNoneMatch string
+		status      int
+	}{
+		{`"abc"`, "", "", http.StatusOK},
+		{`"abc"`, `"abc"`, "", http.StatusOK},
+		{`"abc"`, "*", "", http.StatusOK},
+		{`"abc"`, `"xyz"`, "", http.StatusPreconditionFailed},
+		{`W/"abc"`, `W/"abc"`, "", http.StatusPreconditionFailed},
+		{`"abc"`, "", `"abc"`, http.StatusNotModified},
+		{`"abc"`, "", `W/"abc"`, http.StatusNotModified},
+		{`"abc"`, "", "*", http.StatusNotModified},
+		{`"abc"`, "", `"xyz"`, http.StatusOK},
+
+		// If-Match is evaluated before If-None-Match
+		{`"abc"`, `"xyz"`, `"abc"`, http.StatusPreconditionFailed},
+		{`"abc"`, `"xyz"`, `"xyz"`, http.StatusPreconditionFailed},
+		{`"abc"`, `"abc"`, `"abc"`, http.StatusNotModified},
+		{`"abc"`, `"abc"`, `"xyz"`, http.StatusOK},
+	}
+
+	for _, test := range tests {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/etag/abc", nil)
+		if test.ifMatch != "" {
+			ctx.Request.Header.Set("If-Match", test.ifMatch)
+		}
+		if test.ifNoneMatch != "" {
+			ctx.Request.Header.Set("If-None-Match", test.ifNoneMatch)
+		}
+
+		if status := apiService.CheckETag(ctx, test.etag); status != test.status {
+			t.Errorf("CheckETag(%s, If-Match: %s, If-None-Match: %s) = %d, want %d",
+				test.etag, test.ifMatch, test.ifNoneMatch, status, test.status)
+		}
+	}
+}
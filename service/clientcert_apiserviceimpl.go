@@ -0,0 +1,98 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/response"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"strings"
+	"time"
+)
+
+var ErrNotTLS = errors.New("client certificates are only sent over HTTPS")
+
+// ReturnClientCert implements APIService
+func (t *APIServiceImpl) ReturnClientCert(ctx *gin.Context) (response.ClientCertResponse, error) {
+	state := ctx.Request.TLS
+	if state == nil {
+		return response.ClientCertResponse{}, ErrNotTLS
+	}
+
+	certificates := make([]response.CertificateResponse, 0, len(state.PeerCertificates))
+	for _, cert := range state.PeerCertificates {
+		certificates = append(certificates, describeCertificate(cert))
+	}
+
+	return response.ClientCertResponse{
+		Certificates: certificates,
+		Verified:     len(state.VerifiedChains) > 0,
+	}, nil
+}
+
+func describeCertificate(cert *x509.Certificate) response.CertificateResponse {
+	var sans []string
+	for _, name := range cert.DNSNames {
+		sans = append(sans, "DNS:"+name)
+	}
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, "IP:"+ip.String())
+	}
+	for _, email := range cert.EmailAddresses {
+		sans = append(sans, "email:"+email)
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, "URI:"+uri.String())
+	}
+
+	sha1Sum := sha1.Sum(cert.Raw)
+	sha256Sum := sha256.Sum256(cert.Raw)
+
+	return response.CertificateResponse{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SANs:         sans,
+		SerialNumber: hexColon(cert.SerialNumber.Bytes()),
+		NotBefore:    cert.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:     cert.NotAfter.UTC().Format(time.RFC3339),
+		Fingerprints: map[string]string{
+			"sha1":   hexColon(sha1Sum[:]),
+			"sha256": hexColon(sha256Sum[:]),
+		},
+		KeyType:            keyType(cert.PublicKey),
+		SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+		IsCA:               cert.IsCA,
+	}
+}
+
+// Describes the public key, e.g. "RSA 2048" or "ECDSA P-256"
+func keyType(publicKey interface{}) string {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", key.N.BitLen())
+	case *ecdsa.PublicKey:
+		return "ECDSA " + key.Curve.Params().Name
+	case ed25519.PublicKey:
+		return "Ed25519"
+	}
+	return fmt.Sprintf("%T", publicKey)
+}
+
+// Formats the bytes the way openssl prints them, e.g. "1f:2a:3b"
+func hexColon(data []byte) string {
+	parts := make([]string, len(data))
+	for i, b := range data {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"ServeBin/helper"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"slices"
+)
+
+var ErrEncodingNotAcceptable = errors.New("none of the available content codings is acceptable")
+
+// ChooseEncodings implements APIService
+func (t *APIServiceImpl) ChooseEncodings(ctx *gin.Context, req request.CompressedRequest) ([]string, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return nil, err
+	}
+
+	// Codings asked for explicitly are stacked in the given order
+	if req.Encoding != "" {
+		var encodings []string
+		for _, encoding := range helper.ParseContentEncoding(req.Encoding) {
+			if !slices.Contains(helper.ContentEncodings, encoding) {
+				return nil, fmt.Errorf("%w %q", helper.ErrUnsupportedEncoding, encoding)
+			}
+			if encoding != "identity" {
+				encodings = append(encodings, encoding)
+			}
+		}
+		return encodings, nil
+	}
+
+	_, present := ctx.Request.Header["Accept-Encoding"]
+	encoding, ok := helper.NegotiateEncoding(ctx.GetHeader("Accept-Encoding"), present, helper.ContentEncodings)
+	if !ok {
+		return nil, ErrEncodingNotAcceptable
+	}
+	if encoding == "identity" {
+		return nil, nil
+	}
+	return []string{encoding}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+// ReturnCookies implements APIService
+func (t *APIServiceImpl) ReturnCookies(ctx *gin.Context) map[string]string {
+	cookies := make(map[string]string)
+	for _, cookie := range ctx.Request.Cookies() {
+		cookies[cookie.Name] = cookie.Value
+	}
+	return cookies
+}
+
+// BuildCookie implements APIService
+func (t *APIServiceImpl) BuildCookie(req request.CookieAttributesRequest) (string, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return "", err
+	}
+
+	cookie := http.Cookie{
+		Name:     req.Name,
+		Value:    req.Value,
+		Path:     req.Path,
+		Domain:   req.Domain,
+		Secure:   req.Secure,
+		HttpOnly: req.HttpOnly,
+	}
+
+	switch strings.ToLower(req.SameSite) {
+	case "lax":
+		cookie.SameSite = http.SameSiteLaxMode
+	case "strict":
+		cookie.SameSite = http.SameSiteStrictMode
+	case "none":
+		cookie.SameSite = http.SameSiteNoneMode
+	}
+
+	if req.MaxAge != nil {
+		// net/http omits Max-Age when it's zero, a negative value sends Max-Age=0
+		cookie.MaxAge = *req.MaxAge
+		if cookie.MaxAge == 0 {
+			cookie.MaxAge = -1
+		}
+	}
+
+	setCookie := cookie.String()
+	if setCookie == "" {
+		return "", errors.New("invalid cookie name")
+	}
+
+	if req.Partitioned {
+		setCookie += "; Partitioned"
+	}
+
+	return setCookie, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ComputeDelay implements APIService
+func (t *APIServiceImpl) ComputeDelay(req request.DelayRequest) (time.Duration, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return 0, err
+	}
+
+	// Add the jitter drawn from the requested distribution
+	seconds := req.Seconds
+	switch req.Distribution {
+	case "uniform":
+		seconds += (rand.Float64()*2 - 1) * req.Jitter
+	case "normal":
+		seconds += rand.NormFloat64() * req.Jitter
+	case "exponential":
+		seconds += rand.ExpFloat64() * req.Jitter
+	}
+
+	// Keep the delay within the server limits
+	maxDelay := t.config.Limits.MaxDelay.Duration
+	if seconds > maxDelay.Seconds() {
+		return maxDelay, nil
+	}
+
+	return time.Duration(math.Max(seconds, 0) * float64(time.Second)), nil
+}
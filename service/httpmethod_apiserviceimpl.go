@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"io/ioutil"
+	"log"
 )
 
 // ReturnArguments implements APIService
@@ -71,13 +72,13 @@ func (t *APIServiceImpl) ReturnFormFile(ctx *gin.Context) (map[string]interface{
 				for _, fileHeader := range values {
 					file, err := fileHeader.Open()
 					if err != nil {
-						fmt.Println("Error opening file:", err)
+						log.Printf("Error opening file: %v", err)
 						continue
 					}
 					defer file.Close()
 					fileContents, err := ioutil.ReadAll(file)
 					if err != nil {
-						fmt.Println("Error reading file:", err)
+						log.Printf("Error reading file: %v", err)
 						continue
 					}
 
@@ -113,7 +114,7 @@ func (t *APIServiceImpl) ReturnJson_RawData(ctx *gin.Context) (map[string]interf
 	// Get raw data (JSON payload, text, etc.)
 	rawData, err := ioutil.ReadAll(ctx.Request.Body)
 	if err != nil {
-		fmt.Println("Error reading request body:", err)
+		log.Printf("Error reading request body: %v", err)
 		data["rawData"] = ""
 		data["json"] = ""
 	} else {
@@ -0,0 +1,37 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/response"
+	"time"
+)
+
+// SubscribeRequests implements APIService
+func (t *APIServiceImpl) SubscribeRequests(bin string, path string) *Subscription {
+	return t.broadcaster.Subscribe(bin, path)
+}
+
+// UnsubscribeRequests implements APIService
+func (t *APIServiceImpl) UnsubscribeRequests(subscription *Subscription) {
+	t.broadcaster.Unsubscribe(subscription)
+}
+
+// IsPathWatched implements APIService
+func (t *APIServiceImpl) IsPathWatched(path string) bool {
+	return t.broadcaster.Watching(path)
+}
+
+// PublishRequest implements APIService
+func (t *APIServiceImpl) PublishRequest(bin string, path string, data response.BodyDataResponse, truncated bool) {
+	t.broadcaster.Publish(response.InspectEventResponse{
+		Bin:              bin,
+		Path:             path,
+		Timestamp:        time.Now().UTC(),
+		Truncated:        truncated,
+		BodyDataResponse: data,
+	})
+}
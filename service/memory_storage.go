@@ -0,0 +1,136 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/response"
+	"sync"
+	"time"
+)
+
+type memoryBin struct {
+	meta binMeta
+
+	// Ring buffer of the requests, start points to the oldest one
+	requests []response.BinRequestResponse
+	start    int
+}
+
+// Returns the i-th newest request of the bin
+func (b *memoryBin) newest(i int) response.BinRequestResponse {
+	return b.requests[(b.start+len(b.requests)-1-i)%len(b.requests)]
+}
+
+// MemoryStorage keeps the bins in memory, they are lost on restart
+type MemoryStorage struct {
+	options StorageOptions
+	mu      sync.RWMutex
+	bins    map[string]*memoryBin
+	done    chan struct{}
+}
+
+func NewMemoryStorage(options StorageOptions) *MemoryStorage {
+	storage := &MemoryStorage{
+		options: options,
+		bins:    make(map[string]*memoryBin),
+		done:    make(chan struct{}),
+	}
+
+	go runJanitor(options.TTL, storage.done, storage.evict)
+
+	return storage
+}
+
+// CreateBin implements Storage
+func (s *MemoryStorage) CreateBin(bin response.BinResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.options.MaxBins > 0 && len(s.bins) >= s.options.MaxBins {
+		return ErrStorageFull
+	}
+
+	s.bins[bin.ID] = &memoryBin{
+		meta: binMeta{CreatedAt: bin.CreatedAt, LastSeen: bin.CreatedAt},
+	}
+
+	return nil
+}
+
+// AppendRequest implements Storage
+func (s *MemoryStorage) AppendRequest(binID string, record response.BinRequestResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bins[binID]
+	if !ok {
+		return ErrBinNotFound
+	}
+
+	if err := s.options.checkRequestSize(record); err != nil {
+		return err
+	}
+
+	if s.options.MaxRequests <= 0 || len(b.requests) < s.options.MaxRequests {
+		b.requests = append(b.requests, record)
+	} else {
+		// Overwrite the oldest request
+		b.requests[b.start] = record
+		b.start = (b.start + 1) % len(b.requests)
+	}
+	b.meta.LastSeen = record.Timestamp
+	b.meta.Count = len(b.requests)
+
+	return nil
+}
+
+// ListRequests implements Storage
+func (s *MemoryStorage) ListRequests(binID string, offset int, limit int) ([]response.BinRequestResponse, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, ok := s.bins[binID]
+	if !ok {
+		return nil, 0, ErrBinNotFound
+	}
+
+	requests := make([]response.BinRequestResponse, 0, limit)
+	for i := offset; i < len(b.requests) && len(requests) < limit; i++ {
+		requests = append(requests, b.newest(i))
+	}
+
+	return requests, len(b.requests), nil
+}
+
+// Close implements Storage
+func (s *MemoryStorage) Close() error {
+	close(s.done)
+	return nil
+}
+
+// Drops the idle bins and the requests older than the TTL
+func (s *MemoryStorage) evict(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, b := range s.bins {
+		if b.meta.expired(s.options.TTL, now) {
+			delete(s.bins, id)
+			continue
+		}
+
+		// Keep the requests ordered from the oldest to the newest
+		requests := make([]response.BinRequestResponse, 0, len(b.requests))
+		for i := len(b.requests) - 1; i >= 0; i-- {
+			if record := b.newest(i); now.Sub(record.Timestamp) <= s.options.TTL {
+				requests = append(requests, record)
+			}
+		}
+		b.requests = requests
+		b.start = 0
+		b.meta.Count = len(requests)
+	}
+}
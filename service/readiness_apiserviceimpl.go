@@ -0,0 +1,16 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+// StartDraining implements APIService
+func (t *APIServiceImpl) StartDraining() {
+	t.draining.Store(true)
+}
+
+// IsDraining implements APIService
+func (t *APIServiceImpl) IsDraining() bool {
+	return t.draining.Load()
+}
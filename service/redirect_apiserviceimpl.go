@@ -0,0 +1,73 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// ErrRedirectNotAllowed is returned when the redirect target isn't in the allow-list
+var ErrRedirectNotAllowed = errors.New("redirecting to this host is not allowed")
+
+// ValidateRedirect implements APIService
+func (t *APIServiceImpl) ValidateRedirect(req request.RedirectToRequest) error {
+	if err := t.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	target, err := url.Parse(normalizeRedirectTarget(req.Url))
+	if err != nil {
+		return err
+	}
+
+	// Relative redirects stay on this server
+	if target.Host == "" && target.Scheme == "" {
+		return nil
+	}
+
+	allowedHosts := t.config.Redirect.AllowedHosts
+	if len(allowedHosts) == 0 {
+		return nil
+	}
+
+	if target.Scheme != "" && target.Scheme != "http" && target.Scheme != "https" {
+		return ErrRedirectNotAllowed
+	}
+
+	host := strings.ToLower(target.Hostname())
+	for _, allowed := range allowedHosts {
+		// "*.example.com" allows every subdomain of example.com
+		if host == allowed || (strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:])) {
+			return nil
+		}
+	}
+
+	return ErrRedirectNotAllowed
+}
+
+// Reads the target the way browsers do: tabs and line breaks are dropped,
+// surrounding spaces and control characters trimmed, backslashes taken for
+// slashes and any number of leading slashes starts an authority. Otherwise
+// "/\evil.com" or "///evil.com" would pass for relative redirects.
+func normalizeRedirectTarget(target string) string {
+	target = strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, target)
+	target = strings.TrimFunc(target, func(r rune) bool { return r <= ' ' })
+	target = strings.ReplaceAll(target, "\\", "/")
+
+	if strings.HasPrefix(target, "//") {
+		target = "//" + strings.TrimLeft(target, "/")
+	}
+
+	return target
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/config"
+	"ServeBin/data/request"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"testing"
+)
+
+func TestValidateRedirect(t *testing.T) {
+	cfg := config.Default()
+	cfg.Redirect.AllowedHosts = []string{"example.com", "*.example.org"}
+	apiService := NewAPIServiceImpl(validator.New(), cfg, nil)
+
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{"/get", true},
+		{"get", true},
+		{"/redirect/2?a=b", true},
+		{"https://example.com/path", true},
+		{"//example.com", true},
+		{"http://api.example.org", true},
+		{"https://evil.com", false},
+		{"//evil.com", false},
+		{"///evil.com", false},
+		{"////evil.com", false},
+		{"/\\evil.com", false},
+		{"\\\\evil.com", false},
+		{"/\\/evil.com", false},
+		{"\\\\\\\\evil.com", false},
+		{" //evil.com", false},
+		{"\t//evil.com", false},
+		{"/\t/evil.com", false},
+		{"/\n/evil.com", false},
+		{"\x00//evil.com", false},
+		{"https:\\\\evil.com", false},
+		{"https:evil.com", false},
+		{"https:///evil.com", false},
+		{"javascript:alert(1)", false},
+		{"https://example.com.evil.com", false},
+	}
+
+	for _, test := range tests {
+		err := apiService.ValidateRedirect(request.RedirectToRequest{Url: test.url, StatusCode: 302})
+		if test.allowed && err != nil {
+			t.Errorf("ValidateRedirect(%q) = %v, want allowed", test.url, err)
+		}
+		if !test.allowed && !errors.Is(err, ErrRedirectNotAllowed) {
+			t.Errorf("ValidateRedirect(%q) = %v, want %v", test.url, err, ErrRedirectNotAllowed)
+		}
+	}
+}
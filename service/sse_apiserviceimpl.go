@@ -0,0 +1,45 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PlanSSE implements APIService
+func (t *APIServiceImpl) PlanSSE(req request.SSERequest, lastEventID string) (int, time.Duration, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return 0, 0, err
+	}
+
+	// A line break would end the event field early
+	if strings.ContainsAny(req.Event, "\r\n") {
+		return 0, 0, errors.New("event must not contain line breaks")
+	}
+
+	maxDelay := t.config.Limits.MaxDelay.Duration
+	if req.Interval > maxDelay.Seconds() {
+		return 0, 0, fmt.Errorf("interval must not exceed %s", maxDelay)
+	}
+
+	// Events are numbered from 1, a reconnecting client resumes after the last one it got
+	next := 1
+	if lastEventID != "" {
+		id, err := strconv.Atoi(strings.TrimSpace(lastEventID))
+		// Count is the last event of the stream
+		if err != nil || id < 0 || id > req.Count {
+			return 0, 0, errors.New("Last-Event-ID must be a sequence number")
+		}
+		next = id + 1
+	}
+
+	return next, time.Duration(req.Interval * float64(time.Second)), nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ChooseStatusCode implements APIService
+func (t *APIServiceImpl) ChooseStatusCode(codes string) (int, error) {
+	var choices []int
+	var weights []float64
+	var total float64
+
+	// Codes are given as "200" or weighted as "200:0.8,500:0.2"
+	for _, choice := range strings.Split(codes, ",") {
+		code, weight, hasWeight := strings.Cut(strings.TrimSpace(choice), ":")
+
+		status, err := strconv.Atoi(code)
+		if err != nil {
+			return 0, fmt.Errorf("invalid status code %q", code)
+		}
+		if err := t.Validate.Struct(request.StatusCodesRequest{StatusCode: status}); err != nil {
+			return 0, err
+		}
+
+		w := 1.0
+		if hasWeight {
+			w, err = strconv.ParseFloat(weight, 64)
+			if err != nil || w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+				return 0, fmt.Errorf("invalid weight %q", weight)
+			}
+		}
+
+		choices = append(choices, status)
+		weights = append(weights, w)
+		total += w
+	}
+
+	if total <= 0 {
+		return 0, errors.New("at least one weight must be positive")
+	}
+
+	// Pick a code with a probability proportional to its weight
+	pick := rand.Float64() * total
+	for i, w := range weights {
+		if pick < w {
+			return choices[i], nil
+		}
+		pick -= w
+	}
+
+	return choices[len(choices)-1], nil
+}
+
+// ReturnStatusHeaders implements APIService
+func (t *APIServiceImpl) ReturnStatusHeaders(status int) map[string]string {
+	if status >= 300 && status < 400 && status != http.StatusNotModified {
+		return map[string]string{"Location": "/redirect/1"}
+	}
+
+	switch status {
+	case http.StatusUnauthorized:
+		return map[string]string{"WWW-Authenticate": `Basic realm="ServeBin"`}
+	case http.StatusProxyAuthRequired:
+		return map[string]string{"Proxy-Authenticate": `Basic realm="ServeBin"`}
+	case http.StatusMethodNotAllowed:
+		return map[string]string{"Allow": "GET, POST, PUT, DELETE, PATCH"}
+	case http.StatusRequestedRangeNotSatisfiable:
+		return map[string]string{"Content-Range": "bytes */0"}
+	case http.StatusUpgradeRequired:
+		return map[string]string{"Upgrade": "HTTP/2.0", "Connection": "Upgrade"}
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return map[string]string{"Retry-After": "5"}
+	}
+
+	return nil
+}
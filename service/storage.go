@@ -0,0 +1,111 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/response"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrBinNotFound is returned when the requested bin doesn't exist
+	ErrBinNotFound = errors.New("bin not found")
+
+	// ErrStorageFull is returned when no more bins can be created
+	ErrStorageFull = errors.New("maximum number of bins reached, try again later")
+
+	// ErrRequestTooLarge is returned when the body of a request is bigger than a bin records
+	ErrRequestTooLarge = errors.New("request body is too large to be recorded")
+)
+
+// Storage persists the request bins and the requests captured in them
+type Storage interface {
+	CreateBin(bin response.BinResponse) error
+	AppendRequest(binID string, record response.BinRequestResponse) error
+	ListRequests(binID string, offset int, limit int) ([]response.BinRequestResponse, int, error)
+	Close() error
+}
+
+// StorageOptions holds the limits shared by every storage backend
+type StorageOptions struct {
+	// Path of the database file, used by the on-disk backend only
+	Path string
+
+	// Bins and requests older than TTL are evicted, zero disables the eviction
+	TTL time.Duration
+
+	// Maximum number of requests kept per bin, the oldest are dropped first
+	MaxRequests int
+
+	// Maximum number of bins, zero means unlimited
+	MaxBins int
+
+	// Largest body in bytes recorded per request, zero means unlimited
+	MaxRequestBytes int64
+}
+
+// Fails when the body of the request, before or after decoding, is bigger
+// than a bin records
+func (o StorageOptions) checkRequestSize(record response.BinRequestResponse) error {
+	size := max(record.BodySize, record.DecodedBodySize)
+	if o.MaxRequestBytes > 0 && size > o.MaxRequestBytes {
+		return fmt.Errorf("%w, the limit is %d bytes", ErrRequestTooLarge, o.MaxRequestBytes)
+	}
+	return nil
+}
+
+// Bookkeeping kept alongside every bin
+type binMeta struct {
+	CreatedAt time.Time `json:"created_at"`
+	LastSeen  time.Time `json:"last_seen"`
+	Count     int       `json:"count"`
+}
+
+// Reports whether the bin hasn't seen any activity within the ttl
+func (m binMeta) expired(ttl time.Duration, now time.Time) bool {
+	return ttl > 0 && now.Sub(m.LastSeen) > ttl
+}
+
+// NewStorage creates the storage backend by its name
+func NewStorage(backend string, options StorageOptions) (Storage, error) {
+	switch backend {
+	case "", "memory":
+		return NewMemoryStorage(options), nil
+	case "bolt":
+		return NewBoltStorage(options)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", backend)
+	}
+}
+
+// Runs the eviction periodically until the done channel is closed
+func runJanitor(ttl time.Duration, done <-chan struct{}, evict func(now time.Time)) {
+	if ttl <= 0 {
+		return
+	}
+
+	interval := ttl / 10
+	if interval > time.Minute {
+		interval = time.Minute
+	}
+	if interval < time.Second {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			evict(now.UTC())
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+)
+
+// PlanDrip implements APIService
+func (t *APIServiceImpl) PlanDrip(req request.DripRequest) (time.Duration, time.Duration, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return 0, 0, err
+	}
+
+	maxDelay := t.config.Limits.MaxDelay.Duration
+	if req.Delay > maxDelay.Seconds() || req.Duration > maxDelay.Seconds() {
+		return 0, 0, fmt.Errorf("delay and duration must not exceed %s", maxDelay)
+	}
+
+	delay := time.Duration(req.Delay * float64(time.Second))
+	interval := time.Duration(req.Duration * float64(time.Second) / float64(req.NumBytes))
+
+	return delay, interval, nil
+}
+
+// GenerateBytes implements APIService
+func (t *APIServiceImpl) GenerateBytes(req request.BytesRequest) (io.Reader, int64, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return nil, 0, err
+	}
+
+	if maxBytes := t.config.Limits.MaxBytes; req.N > maxBytes {
+		return nil, 0, fmt.Errorf("n must not exceed %d bytes", maxBytes)
+	}
+
+	// The same seed always generates the same bytes
+	seed := time.Now().UnixNano()
+	if req.Seed != nil {
+		seed = *req.Seed
+	}
+
+	return io.LimitReader(rand.New(rand.NewSource(seed)), int64(req.N)), seed, nil
+}
+
+// StreamBytes implements APIService
+func (t *APIServiceImpl) StreamBytes(req request.StreamBytesRequest) (io.Reader, int64, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return nil, 0, err
+	}
+
+	return t.GenerateBytes(request.BytesRequest{N: req.N, Seed: req.Seed})
+}
+
+// GenerateRangeContent implements APIService
+func (t *APIServiceImpl) GenerateRangeContent(n int) ([]byte, error) {
+	if maxBytes := t.config.Limits.MaxBytes; n < 1 || n > maxBytes {
+		return nil, fmt.Errorf("n must be between 1 and %d bytes", maxBytes)
+	}
+
+	// Repeat the alphabet so that every offset is easy to check
+	content := make([]byte, n)
+	for i := range content {
+		content[i] = byte('a' + i%26)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 The ServeBin AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"ServeBin/data/request"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PlanWebSocketEcho implements APIService
+func (t *APIServiceImpl) PlanWebSocketEcho(req request.WebSocketEchoRequest) (time.Duration, time.Duration, error) {
+	if err := t.Validate.Struct(req); err != nil {
+		return 0, 0, err
+	}
+
+	// 1004-1006 and 1015 must never be sent, 1016-2999 are kept for the protocol, RFC 6455 section 7.4
+	if (req.CloseCode >= 1004 && req.CloseCode <= 1006) || (req.CloseCode >= 1015 && req.CloseCode < 3000) {
+		return 0, 0, fmt.Errorf("close code %d can't be sent in a close frame", req.CloseCode)
+	}
+
+	// The reason shares the 125 bytes of a control frame payload with the code
+	if len(req.CloseReason) > 123 {
+		return 0, 0, errors.New("close_reason must not exceed 123 bytes")
+	}
+
+	maxDelay := t.config.Limits.MaxDelay.Duration
+	if req.Delay > maxDelay.Seconds() {
+		return 0, 0, fmt.Errorf("delay must not exceed %s", maxDelay)
+	}
+
+	pingInterval := time.Duration(req.PingInterval * float64(time.Second))
+	delay := time.Duration(req.Delay * float64(time.Second))
+
+	return pingInterval, delay, nil
+}